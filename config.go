@@ -1,11 +1,97 @@
 package tygojaPB
 
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
 const (
-	defaultIndent = "  "
+	defaultIndent               = "  "
+	defaultSumTypeDiscriminator = "type"
+	defaultComplexType          = "{ real: number; imag: number }"
+	defaultNumberType           = "number"
+	defaultBoolType             = "boolean"
+	defaultStringType           = "string"
+	defaultFallbackType         = "any"
+	defaultFuncReturnVoidType   = "void"
+	defaultErrorType            = "Error"
+	defaultInternalMarker       = "tygoja:internal"
 
 	// custom base types that every package has access to
 	BaseTypeDict = "_TygojaDict" // Record type alternative as a more generic map-like type
 	BaseTypeAny  = "_TygojaAny"  // any type alias to allow easier extends generation
+
+	// branded number base type of the time.Duration (see DurationTypeBranded)
+	BaseTypeDuration = "_TygojaDuration"
+)
+
+// defaultTypeMappings specifies the builtin TypeMappings
+// that are applied unless explicitly overwritten.
+var defaultTypeMappings = map[string]string{
+	// special case for the unsafe package because it doesn't return its types in pkg.Syntax
+	// (arbitrary memory pointers are not meaningful in JS so we default to any)
+	"unsafe.Pointer": "any",
+
+	// arbitrary encoded JSON value ([]byte alias)
+	"json.RawMessage": "any",
+
+	// opaque concurrency primitives without meaningful JS representation
+	// (the embedded ones are omitted from the struct "extends" clause)
+	"sync.Map":     BaseTypeDict,
+	"sync.Mutex":   "any",
+	"sync.RWMutex": "any",
+}
+
+// Supported Config.DeclarationMode values.
+const (
+	// DeclarationModeNamespace wraps each package declarations
+	// in a plain "namespace pkg { ... }" block (default).
+	DeclarationModeNamespace = "namespace"
+
+	// DeclarationModeAmbientGlobal wraps each package declarations
+	// in an explicit ambient "declare namespace pkg { ... }" block.
+	DeclarationModeAmbientGlobal = "ambient-global"
+
+	// DeclarationModeModule exports each package namespace and its declarations
+	// and, if Config.ModuleName is set, wraps all of them in a
+	// "declare module "ModuleName" { ... }" block.
+	DeclarationModeModule = "module"
+)
+
+// Supported Config.EnumStyle values.
+const (
+	// EnumStyleEnum writes the typed const groups as regular TS "enum".
+	EnumStyleEnum = "enum"
+
+	// EnumStyleConstEnum writes the typed const groups as TS "const enum",
+	// whose values are inlined at compile time.
+	//
+	// Note that the ambient const enums can't be used with the
+	// "isolatedModules" TS compiler option (and the transpilers that rely on it).
+	EnumStyleConstEnum = "const-enum"
+)
+
+// Supported Config.DurationType values.
+const (
+	// DurationTypeNumber writes the time.Duration as plain NumberType (aka. nanoseconds).
+	DurationTypeNumber = "number"
+
+	// DurationTypeBranded writes the time.Duration as the BaseTypeDuration
+	// branded number alias (eg. "number & { readonly __brand: "Duration" }")
+	// so that it can't be mixed accidentally with the other numbers.
+	DurationTypeBranded = "branded"
+)
+
+// Supported Config.ArraySyntax values.
+const (
+	// ArraySyntaxGeneric writes the Go slices and arrays
+	// using the generic "Array<T>" syntax (default).
+	ArraySyntaxGeneric = "generic"
+
+	// ArraySyntaxShorthand writes the Go slices and arrays
+	// using the shorthand "T[]" syntax.
+	ArraySyntaxShorthand = "shorthand"
 )
 
 // FieldNameFormatterFunc defines a function for formatting a field name.
@@ -14,6 +100,11 @@ type FieldNameFormatterFunc func(string) string
 // MethodNameFormatterFunc defines a function for formatting a method name.
 type MethodNameFormatterFunc func(string) string
 
+// TypeNameFormatterFunc defines a function for formatting a top level declaration name.
+//
+// kind is one of the DeclarationKindType, DeclarationKindFunc and DeclarationKindConst constants.
+type TypeNameFormatterFunc func(kind string, name string) string
+
 type Config struct {
 	// Packages is a list of package paths just like you would import them in Go.
 	// Use "*" to generate all package types.
@@ -26,17 +117,59 @@ type Config struct {
 	// 	}
 	Packages map[string][]string
 
+	// Roots specifies an optional list of entry point types and package
+	// functions (in the format "pkgPath.Name") to limit the generated
+	// declarations only to them and to their transitively referenced types.
+	//
+	// The reachable types are resolved from the roots DeclarationGraph
+	// and when set, the Packages type lists are ignored (Packages could be
+	// also omitted entirely).
+	//
+	// Example:
+	//
+	// 	Roots: []string{"github.com/hanzoai/backendPB/core.App"}
+	Roots []string
+
 	// Heading specifies a content that will be put at the top of the output declaration file.
 	//
 	// You would generally use this to import custom types or some custom TS declarations.
 	Heading string
 
+	// Globals specifies global variable declarations in the format
+	// "var name" => "Go type expression".
+	//
+	// The Go type expressions are resolved the same way as the
+	// generated struct fields (including the TypeMappings).
+	//
+	// Example:
+	//
+	// 	Globals: map[string]string{
+	// 		"$app":  "*core.App",
+	// 		"$tags": "[]string",
+	// 	}
+	//
+	// will be generated as:
+	//
+	// 	declare var $app: core.App
+	// 	declare var $tags: Array<string>
+	Globals map[string]string
+
 	// TypeMappings specifies custom type translations.
 	//
 	// Useful for for mapping 3rd party package types, eg "unsafe.Pointer" => "CustomType".
 	//
+	// The mappings are applied to every occurrence of the type, including
+	// nested element types (eg. "[]CustomType" => "Array<MyTSType>",
+	// "...CustomType" => "...MyTSType[]", "*CustomType" => "MyTSType | undefined").
+	//
 	// Be default unrecognized types will be recursively generated by
 	// traversing their import package (when possible).
+	//
+	// Some well-known types have builtin default mappings that could be
+	// overwritten (eg. "unsafe.Pointer" => "any", "sync.Map" => "_TygojaDict").
+	//
+	// Note that the embedded struct types mapped to a primitive TS type (eg. "any")
+	// are not included in the struct "extends" clause since they cannot be extended.
 	TypeMappings map[string]string
 
 	// WithConstants indicates whether to generate types for constants
@@ -47,15 +180,440 @@ type Config struct {
 	// for package level functions ("false" by default).
 	WithPackageFunctions bool
 
+	// CollapseSingleFieldStructs indicates whether to generate struct types
+	// with exactly one exported field and no methods as a type alias of
+	// the field type ("false" by default).
+	//
+	// For example "type Wrapper struct { Value T }" will be generated as "type Wrapper = T".
+	CollapseSingleFieldStructs bool
+
+	// SumTypeInterfaces specifies a list of interfaces (in the format "pkgPath.InterfaceName")
+	// for which to generate an additional discriminated union type alias
+	// from all of their struct implementations in the same package.
+	//
+	// For example, if "github.com/example/api.Result" is implemented by
+	// the "Success" and "Failure" structs, the generator will also write:
+	//
+	// 	type ResultUnion = (Success & { type: "Success" }) | (Failure & { type: "Failure" })
+	SumTypeInterfaces []string
+
+	// SumTypeDiscriminator specifies the name of the discriminant
+	// property used in the SumTypeInterfaces unions ("type" by default).
+	SumTypeDiscriminator string
+
+	// DropUnsafeFields indicates whether to skip generating struct
+	// fields of unsafe.Pointer type ("false" by default).
+	DropUnsafeFields bool
+
+	// ExpandMapTypes indicates whether to generate the Go maps with string
+	// or numeric keys as TS index signature type instead of the generic
+	// _TygojaDict ("false" by default).
+	//
+	// For example "map[string]any" will be generated as "{ [key: string]: any }".
+	ExpandMapTypes bool
+
+	// MapTypeTemplate specifies an optional text/template string used to
+	// write the Go map types, where ".Key" and ".Value" are the TS
+	// representations of the map key and value types.
+	//
+	// For example "Map<{{.Key}}, {{.Value}}>" or "{ [key: {{.Key}}]: {{.Value}} }".
+	//
+	// When set it takes precedence over ExpandMapTypes.
+	// If empty, the maps are written as "_TygojaDict" (or as index signature with ExpandMapTypes).
+	MapTypeTemplate string
+
+	// ArraySyntax specifies how the Go slices and arrays are written
+	// (ArraySyntaxGeneric by default).
+	//
+	// With ArraySyntaxShorthand the nested slices are written as "number[][]"
+	// instead of "Array<Array<number>>" and the union element types
+	// are wrapped in parenthesis (eg. "(User | undefined)[]").
+	//
+	// Note that the "extends" clauses always use the generic syntax.
+	ArraySyntax string
+
+	// InlineUnexportedEmbeds indicates whether to flatten the exported fields
+	// and methods of the embedded unexported structs directly in their parent
+	// struct declaration instead of extending them ("false" by default).
+	//
+	// This matches the goja fields promotion, eg.:
+	//
+	// 	type base struct { ID int }
+	// 	type User struct { base; Name string }
+	//
+	// will be generated as "interface User { Name: string; ID: number }".
+	InlineUnexportedEmbeds bool
+
+	// CompactEmptyTypes indicates whether to generate the empty structs
+	// as "{}" and the zero length arrays (eg. "[0]int") as empty tuple "[]"
+	// ("false" by default).
+	CompactEmptyTypes bool
+
+	// NumberType specifies the TS type of the Go numeric types (defaultNumberType by default).
+	//
+	// It could be used to globally remap the numbers to a custom or
+	// branded type (eg. "GoNumber" declared in the Heading as "type GoNumber = number").
+	//
+	// The more specific numeric options (eg. Int64AsBigInt, ComplexType) take precedence.
+	NumberType string
+
+	// DurationType specifies how to write the time.Duration type
+	// (empty by default, aka. generated as any other type, including its methods).
+	//
+	// See DurationTypeNumber and DurationTypeBranded.
+	//
+	// It has no effect if there is an explicit "time.Duration" TypeMappings entry.
+	DurationType string
+
+	// BoolType specifies the TS type of the Go bool type (defaultBoolType by default).
+	//
+	// It could be used to remap the booleans to a custom or
+	// branded type (eg. "0 | 1" or "GoBool" declared in the Heading).
+	BoolType string
+
+	// StringType specifies the TS type of the Go string type (defaultStringType by default).
+	StringType string
+
+	// ResolveNamedBasicTypes indicates whether to write the references to
+	// the not generated method-less named types of the current package with
+	// basic underlying type (eg. "type Email string") as their underlying
+	// type instead of generating them separately ("false" by default).
+	//
+	// For example, if only "User" is allowed from the package, the
+	// "User.Email" field will be generated as "Email: string".
+	ResolveNamedBasicTypes bool
+
+	// InlineNamedTypes is similar to ResolveNamedBasicTypes but also
+	// inlines the not generated method-less named slice, array and map
+	// types (eg. "type Tags []string" => "Array<string>") ("false" by default).
+	//
+	// The struct, interface and generic named types are never inlined.
+	InlineNamedTypes bool
+
+	// EnumStyle specifies whether to write the exported consts of the
+	// method-less named integer and string types as TS enum declarations
+	// instead of separate consts (empty by default, aka. no enums).
+	//
+	// For example "type Status int" with "const ( Active Status = iota; Inactive )"
+	// will be generated with EnumStyleConstEnum as:
+	//
+	// 	const enum Status {
+	// 	  Active = 0,
+	// 	  Inactive = 1,
+	// 	}
+	//
+	// It has effect only when WithConstants is enabled.
+	// See EnumStyleEnum and EnumStyleConstEnum.
+	EnumStyle string
+
+	// DefaultFallbackType specifies the TS type of the Go empty
+	// interfaces and of the unsupported Go types (defaultFallbackType by default).
+	//
+	// Set it to "unknown" for a stricter output (aka. strict mode),
+	// in which case the ExpandMapTypes maps are also written as
+	// "Record" (eg. "map[string]any" => "Record<string, unknown>").
+	DefaultFallbackType string
+
+	// FuncReturnVoidType specifies the TS return type of the Go functions
+	// without results or with only an error result (defaultFuncReturnVoidType by default).
+	//
+	// For example, set it to "undefined" for "() => undefined" func props.
+	FuncReturnVoidType string
+
+	// DistinguishIntFloat indicates whether to preserve the Go numeric
+	// type names (int64, float32, etc.) in the generated declarations
+	// ("false" by default).
+	//
+	// The numeric types are declared once as NumberType aliases
+	// (eg. "type int32 = number") so that the signatures could read as "count: int32".
+	DistinguishIntFloat bool
+
+	// Int64AsBigInt indicates whether to generate the int64, uint64 and uintptr
+	// Go types as TS "bigint" instead of "number" ("false" by default).
+	//
+	// Note that the TS index signatures don't allow "bigint" keys so the
+	// ExpandMapTypes map keys are still generated as "number" (the
+	// MapTypeTemplate Key on the other hand is resolved as "bigint").
+	Int64AsBigInt bool
+
+	// FieldTagKey specifies an optional struct tag key (eg. "json" or "js")
+	// from which to extract the generated field names.
+	//
+	// The field tagged with "-" is skipped. If the tag is missing or empty,
+	// the Go field name is used.
+	//
+	// Note that the FieldNameFormatter (if any) is applied after the tag name extraction.
+	FieldTagKey string
+
+	// SortFields indicates whether to sort the generated struct fields
+	// alphabetically by their final TS name ("false" by default, aka. source order).
+	SortFields bool
+
+	// ComplexType specifies the TS type of the complex64 and complex128
+	// Go types (defaultComplexType by default).
+	ComplexType string
+
+	// TagToJSDoc specifies struct tag keys from which to generate
+	// JSDoc tags for the struct fields in the format "tagKey" => "jsdocTag".
+	//
+	// If the "jsdocTag" is empty, the tag value is treated as a comma separated
+	// list of "name" or "name=value" JSDoc tags, otherwise the tag value
+	// is used as it is.
+	//
+	// Example:
+	//
+	// 	TagToJSDoc: map[string]string{"doc": "", "example": "example"}
+	//
+	// 	Field string `doc:"readonly,since=1.2" example:"abc"`
+	//
+	// will generate:
+	//
+	// 	/**
+	// 	 * @readonly
+	// 	 * @since 1.2
+	// 	 * @example abc
+	// 	 */
+	// 	Field: string
+	TagToJSDoc map[string]string
+
+	// EmitStructTags indicates whether to append the raw Go struct field
+	// tags as "@gotag" JSDoc tag of the generated fields ("false" by default).
+	//
+	// For example `json:"name,omitempty"` will be generated as:
+	//
+	// 	/**
+	// 	 * @gotag json:"name,omitempty"
+	// 	 */
+	EmitStructTags bool
+
+	// MaxInlineDepth specifies the max allowed nesting level of the
+	// inlined anonymous structs (0 means no limit).
+	//
+	// The anonymous structs beyond the limit are generated as "any"
+	// followed by a comment with their source position.
+	MaxInlineDepth int
+
+	// ExplicitThisParam indicates whether to generate the method receivers
+	// as explicit TS "this" parameter (eg. "Method(this: Foo, a: number): void")
+	// to help catching misuse of detached methods ("false" by default).
+	ExplicitThisParam bool
+
+	// ErrorType specifies the TS type of the Go builtin error interface
+	// ("Error" by default).
+	//
+	// Note that it doesn't affect the functions last error return value
+	// since it is converted by goja into an exception.
+	ErrorType string
+
+	// InternalMarker specifies a doc comment line that marks
+	// a declaration as internal ("tygoja:internal" by default).
+	//
+	// The internal declarations are generated with "@internal" JSDoc tag
+	// or skipped entirely if HideInternal is set.
+	//
+	// Example:
+	//
+	// 	// Something is an internal helper.
+	// 	//
+	// 	//tygoja:internal
+	// 	type Something struct {}
+	InternalMarker string
+
+	// HideInternal indicates whether to skip generating the declarations
+	// marked with the InternalMarker ("false" by default).
+	HideInternal bool
+
+	// ExcludeMarkers specifies a list of doc comment substrings
+	// (eg. "[EXPERIMENTAL]") that exclude the documented declarations,
+	// struct fields and interface methods from the generated output.
+	ExcludeMarkers []string
+
+	// DeprecatedAsJSDoc indicates whether to convert the Go doc
+	// "Deprecated:" paragraphs to JSDoc "@deprecated" tags ("false" by default).
+	//
+	// A version mentioned in the paragraph (eg. "Since v1.2" or "as of 1.2")
+	// is also extracted as "@since" tag.
+	DeprecatedAsJSDoc bool
+
+	// SeeAlsoAsJSDoc indicates whether to convert the Go doc "See also ..."
+	// lines to JSDoc "@see {@link Name}" tags ("false" by default).
+	//
+	// To minimize the false positives, a line is converted only if all of its
+	// references are generated declarations of the same package
+	// (eg. "See also [Foo], Bar and Baz.Method.").
+	SeeAlsoAsJSDoc bool
+
+	// PackageDocumentation indicates whether to mark the Go package
+	// doc comments with the typedoc "@packageDocumentation"
+	// tag ("false" by default).
+	//
+	// It is mostly useful with GenerateFiles and GenerateTree
+	// where each package has its own declaration file.
+	PackageDocumentation bool
+
+	// DeclarationFilter allows specifying a custom top level declarations filter
+	// (types, functions, methods and constants).
+	//
+	// Returning false omits the declaration from the generated output.
+	DeclarationFilter DeclarationFilterFunc
+
+	// VariadicByteAsArray indicates whether to generate the variadic
+	// byte params as number array (eg. "...args: number[]") instead of
+	// the default goja string interop shortcut ("...args: string").
+	VariadicByteAsArray bool
+
+	// GeneratePartialVariants specifies a list of structs (in the format "pkgPath.StructName")
+	// for which to generate an additional "Partial" utility type alias.
+	//
+	// For example "github.com/example/api.Foo" will also generate:
+	//
+	// 	type FooPartial = Partial<Foo>
+	GeneratePartialVariants []string
+
+	// StructAsType indicates whether to generate the structs as
+	// type aliases (eg. "type Foo = { ... }") instead of interfaces
+	// (eg. "interface Foo { ... }") to prevent declarations merging
+	// ("false" by default).
+	//
+	// Since the type aliases can't be merged, the struct methods
+	// are inlined in the struct type literal.
+	StructAsType bool
+
+	// NarrowInterfaceReturns indicates whether to replace the interface
+	// return type of the package level functions with the concrete type
+	// that they always return ("false" by default).
+	//
+	// The narrowing is conservative and it is applied only for constructor-like functions
+	// whose return statements are all composite literals of the same type, eg.:
+	//
+	// 	func NewStore() (Store, error) { return &memoryStore{}, nil }
+	//
+	// will be generated as "(): memoryStore" instead of "(): Store".
+	NarrowInterfaceReturns bool
+
+	// NamedResultsAsJSDoc indicates whether to describe the tuple shape of
+	// the functions with multiple named results with "@returns" JSDoc tag
+	// ("false" by default).
+	//
+	// The named results comments (if any) are also included in the tag description.
+	NamedResultsAsJSDoc bool
+
+	// FileFilter allows specifying a custom filter for the package files
+	// whose declarations should be generated (eg. to skip "*_gen.go" files).
+	//
+	// The function receives the absolute file path and returning false skips the file.
+	FileFilter func(path string) bool
+
+	// IteratorsAsIterable indicates whether to generate the Go 1.23
+	// range-over-func iterator signatures (eg. "iter.Seq[T]") as
+	// TS "Iterable<T>" ("false" by default).
+	//
+	// The two values iterators (eg. "iter.Seq2[K, V]") are generated as "Iterable<[K, V]>".
+	IteratorsAsIterable bool
+
+	// ChannelsAsAsyncIterable indicates whether to generate the Go channel
+	// types as TS "AsyncIterable<T>" ("false" by default, aka. "undefined").
+	ChannelsAsAsyncIterable bool
+
+	// ChannelType specifies an optional generic TS type name used to
+	// wrap the Go channel element types, eg. "ReadableStream" will
+	// generate "<-chan Event" as "ReadableStream<Event>".
+	//
+	// When set it takes precedence over ChannelsAsAsyncIterable.
+	// The channel direction (send-only, receive-only or bidirectional) is not differentiated.
+	ChannelType string
+
+	// LinkifyDocs indicates whether to replace the doc comments mentions of
+	// the generated package types with JSDoc "{@link TypeName}" references
+	// ("false" by default).
+	//
+	// To minimize the false positives only the whole word exported type
+	// names of the same package are replaced (excluding code blocks and spans
+	// and the other packages qualified references, eg. "keys.Code").
+	LinkifyDocs bool
+
+	// TopoSort indicates whether to order each package declarations
+	// so that the referenced types are declared before their dependents
+	// ("false" by default, aka. the Go source order).
+	//
+	// The cyclic declarations are kept together in their source order.
+	TopoSort bool
+
+	// DocExamples indicates whether to append the package Go testable
+	// examples (the "Example*" functions from the *_test.go files)
+	// as JSDoc "@example" blocks to their related declarations
+	// ("false" by default).
+	//
+	// The example "// Output:" expectations are preserved as part of the code block.
+	DocExamples bool
+
 	// FieldNameFormatter allows specifying a custom struct field name formatter.
 	FieldNameFormatter FieldNameFormatterFunc
 
 	// MethodNameFormatter allows specifying a custom method name formatter.
 	MethodNameFormatter MethodNameFormatterFunc
 
+	// TypeHandler allows specifying a custom handler for the Go type
+	// expressions that is invoked before the builtin handling
+	// (eg. to support an unusual construct like *ast.CompositeLit).
+	TypeHandler TypeHandlerFunc
+
+	// TypeNameFormatter allows specifying a custom top level type, func and const name formatter
+	// (eg. to strip a common prefix).
+	//
+	// The type references (including the ones from other packages) are renamed consistently.
+	//
+	// Note that for package level functions it is applied after the MethodNameFormatter.
+	TypeNameFormatter TypeNameFormatterFunc
+
+	// DeclarationMode specifies how the generated package declarations
+	// are scoped (DeclarationModeNamespace by default).
+	//
+	// See DeclarationModeNamespace, DeclarationModeAmbientGlobal and DeclarationModeModule.
+	DeclarationMode string
+
+	// NamespacePerPackage is a shorthand for the DeclarationModeAmbientGlobal
+	// DeclarationMode, aka. wraps each package declarations in its own
+	// "declare namespace pkg { ... }" block ("false" by default).
+	//
+	// It cannot be combined with a different explicit DeclarationMode.
+	NamespacePerPackage bool
+
+	// ModuleOutput is a shorthand for the DeclarationModeModule
+	// DeclarationMode ("false" by default).
+	//
+	// Unlike the default ambient namespaces that are loaded in the global
+	// scope as a script, the module output exports every package namespace,
+	// declaration and base type so that they have to be explicitly imported
+	// (eg. "import type { pkg } from './types'").
+	//
+	// It cannot be combined with NamespacePerPackage or with a different explicit DeclarationMode.
+	ModuleOutput bool
+
+	// ModuleName specifies the name of the "declare module" wrapper
+	// when DeclarationMode is DeclarationModeModule.
+	//
+	// If empty, no module wrapper is generated and the exported
+	// declarations are written at the top level of the output.
+	ModuleName string
+
+	// ModuleGuard indicates whether to append an empty "export {};" statement
+	// at the end of the Generate output so that TS treats the file as
+	// a module instead of a global script ("false" by default).
+	//
+	// Note that in a module the top level declarations are no longer
+	// in the global scope and they need to be explicitly imported
+	// (or augmented with "declare global { ... }" in the Heading).
+	//
+	// It has no effect when DeclarationMode is DeclarationModeModule because
+	// its declarations are already exported or wrapped in a "declare module" block.
+	ModuleGuard bool
+
 	// StartModifier usually should be "export" or declare but as of now prevents
 	// the LSP autocompletion so we keep it empty.
 	//
+	// When DeclarationMode is DeclarationModeModule, it must be "export" (or empty to be auto set).
+	//
 	// See also:
 	// https://github.com/microsoft/TypeScript/issues/54330
 	// https://github.com/microsoft/TypeScript/pull/49644
@@ -63,6 +621,15 @@ type Config struct {
 
 	// Indent allow customizing the default indentation (use \t if you want tabs).
 	Indent string
+
+	// MaxLineLength specifies the max line length after which the func
+	// params and the union type members are wrapped on multiple lines
+	// (0 by default, aka. no wrapping).
+	//
+	// Note that the length is checked only against the currently written
+	// part of the declaration (eg. the func return type is not accounted)
+	// so some lines could still exceed it.
+	MaxLineLength int
 }
 
 // Initializes the defaults (if not already) of the current config.
@@ -71,12 +638,185 @@ func (c *Config) InitDefaults() {
 		c.Indent = defaultIndent
 	}
 
+	if c.DeclarationMode == "" {
+		if c.ModuleOutput {
+			c.DeclarationMode = DeclarationModeModule
+		} else if c.NamespacePerPackage {
+			c.DeclarationMode = DeclarationModeAmbientGlobal
+		} else {
+			c.DeclarationMode = DeclarationModeNamespace
+		}
+	}
+
+	if c.DeclarationMode == DeclarationModeModule && c.StartModifier == "" {
+		c.StartModifier = "export"
+	}
+
+	if c.ArraySyntax == "" {
+		c.ArraySyntax = ArraySyntaxGeneric
+	}
+
+	if c.InternalMarker == "" {
+		c.InternalMarker = defaultInternalMarker
+	}
+
+	if c.ErrorType == "" {
+		c.ErrorType = defaultErrorType
+	}
+
+	if c.NumberType == "" {
+		c.NumberType = defaultNumberType
+	}
+
+	if c.BoolType == "" {
+		c.BoolType = defaultBoolType
+	}
+
+	if c.StringType == "" {
+		c.StringType = defaultStringType
+	}
+
+	if c.DefaultFallbackType == "" {
+		c.DefaultFallbackType = defaultFallbackType
+	}
+
+	if c.FuncReturnVoidType == "" {
+		c.FuncReturnVoidType = defaultFuncReturnVoidType
+	}
+
+	if c.ComplexType == "" {
+		c.ComplexType = defaultComplexType
+	}
+
+	if c.SumTypeDiscriminator == "" {
+		c.SumTypeDiscriminator = defaultSumTypeDiscriminator
+	}
+
 	if c.TypeMappings == nil {
 		c.TypeMappings = make(map[string]string)
 	}
 
-	// special case for the unsafe package because it doesn't return its types in pkg.Syntax
-	if _, ok := c.TypeMappings["unsafe.Pointer"]; !ok {
-		c.TypeMappings["unsafe.Pointer"] = "number"
+	for k, v := range defaultTypeMappings {
+		if _, ok := c.TypeMappings[k]; !ok {
+			c.TypeMappings[k] = v
+		}
+	}
+
+	if _, ok := c.TypeMappings["time.Duration"]; !ok {
+		switch c.DurationType {
+		case DurationTypeNumber:
+			c.TypeMappings["time.Duration"] = c.NumberType
+		case DurationTypeBranded:
+			c.TypeMappings["time.Duration"] = BaseTypeDuration
+		}
+	}
+}
+
+// Validate checks the current config for invalid or conflicting settings.
+func (c Config) Validate() error {
+	if len(c.Packages) == 0 && len(c.Roots) == 0 {
+		return fmt.Errorf("at least one package or root must be specified")
+	}
+
+	if strings.TrimSpace(c.Indent) != "" {
+		return fmt.Errorf("invalid indent %q, only whitespace characters are allowed", c.Indent)
+	}
+
+	if c.MaxLineLength < 0 {
+		return fmt.Errorf("MaxLineLength must be >= 0, got %d", c.MaxLineLength)
+	}
+
+	if c.MaxInlineDepth < 0 {
+		return fmt.Errorf("MaxInlineDepth must be >= 0, got %d", c.MaxInlineDepth)
+	}
+
+	if c.ModuleOutput && c.NamespacePerPackage {
+		return fmt.Errorf("ModuleOutput and NamespacePerPackage cannot be used together")
+	}
+
+	if c.ModuleOutput && c.DeclarationMode != "" && c.DeclarationMode != DeclarationModeModule {
+		return fmt.Errorf("ModuleOutput conflicts with the %q DeclarationMode", c.DeclarationMode)
+	}
+
+	if c.NamespacePerPackage && c.DeclarationMode != "" && c.DeclarationMode != DeclarationModeAmbientGlobal {
+		return fmt.Errorf("NamespacePerPackage conflicts with the %q DeclarationMode", c.DeclarationMode)
+	}
+
+	switch c.DeclarationMode {
+	case "", DeclarationModeNamespace, DeclarationModeAmbientGlobal:
+		if c.ModuleName != "" {
+			return fmt.Errorf("ModuleName can be used only with the %q DeclarationMode", DeclarationModeModule)
+		}
+	case DeclarationModeModule:
+		if c.StartModifier != "" && c.StartModifier != "export" {
+			return fmt.Errorf("StartModifier %q conflicts with the %q DeclarationMode", c.StartModifier, DeclarationModeModule)
+		}
+	default:
+		return fmt.Errorf("unknown DeclarationMode %q", c.DeclarationMode)
+	}
+
+	if c.ChannelType != "" && !isValidJSNameRegexp.MatchString(strings.ReplaceAll(c.ChannelType, ".", "")) {
+		return fmt.Errorf("invalid ChannelType %q, expected a generic type name without type arguments", c.ChannelType)
+	}
+
+	if c.MapTypeTemplate != "" {
+		if _, err := template.New("map").Parse(c.MapTypeTemplate); err != nil {
+			return fmt.Errorf("invalid MapTypeTemplate: %w", err)
+		}
+	}
+
+	switch c.DurationType {
+	case "", DurationTypeNumber, DurationTypeBranded:
+	default:
+		return fmt.Errorf("unknown DurationType %q", c.DurationType)
+	}
+
+	switch c.EnumStyle {
+	case "", EnumStyleEnum, EnumStyleConstEnum:
+	default:
+		return fmt.Errorf("unknown EnumStyle %q", c.EnumStyle)
 	}
+
+	switch c.ArraySyntax {
+	case "", ArraySyntaxGeneric, ArraySyntaxShorthand:
+	default:
+		return fmt.Errorf("unknown ArraySyntax %q", c.ArraySyntax)
+	}
+
+	for _, name := range c.Roots {
+		if !isQualifiedTypeName(name) {
+			return fmt.Errorf("invalid Roots entry %q, expected \"pkgPath.Name\" format", name)
+		}
+	}
+
+	for _, name := range c.SumTypeInterfaces {
+		if !isQualifiedTypeName(name) {
+			return fmt.Errorf("invalid SumTypeInterfaces entry %q, expected \"pkgPath.InterfaceName\" format", name)
+		}
+	}
+
+	for _, name := range c.GeneratePartialVariants {
+		if !isQualifiedTypeName(name) {
+			return fmt.Errorf("invalid GeneratePartialVariants entry %q, expected \"pkgPath.StructName\" format", name)
+		}
+	}
+
+	for name, typ := range c.Globals {
+		if !isValidJSNameRegexp.MatchString(strings.TrimPrefix(name, "$")) || isReservedIdentifier(name) {
+			return fmt.Errorf("invalid global variable name %q", name)
+		}
+
+		if strings.TrimSpace(typ) == "" {
+			return fmt.Errorf("missing global variable %q type", name)
+		}
+	}
+
+	return nil
+}
+
+// isQualifiedTypeName checks whether name is in the "pkgPath.TypeName" format.
+func isQualifiedTypeName(name string) bool {
+	idx := strings.LastIndex(name, ".")
+
+	return idx > 0 && idx < len(name)-1
 }
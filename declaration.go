@@ -0,0 +1,71 @@
+package tygojaPB
+
+import "go/ast"
+
+// Supported Declaration.Kind values.
+const (
+	DeclarationKindType   = "type"
+	DeclarationKindFunc   = "func"
+	DeclarationKindMethod = "method"
+	DeclarationKindConst  = "const"
+)
+
+// Declaration describes a single top level Go declaration
+// that is about to be generated.
+type Declaration struct {
+	// Package is the declaration package import path.
+	Package string
+
+	// Kind is the declaration kind (see the DeclarationKind* constants).
+	Kind string
+
+	// Name is the declaration Go name.
+	//
+	// For methods the name is in the format "ReceiverName.MethodName".
+	Name string
+
+	// Doc is the declaration doc comment text (if any).
+	Doc string
+}
+
+// DeclarationFilterFunc defines a function for filtering the generated declarations.
+//
+// Returning false omits the declaration.
+type DeclarationFilterFunc func(d Declaration) bool
+
+// isDeclarationAllowed checks whether the specified top level declaration
+// should be generated based on the Config.HideInternal and Config.DeclarationFilter options.
+func (g *PackageGenerator) isDeclarationAllowed(kind string, name string, doc *ast.CommentGroup) bool {
+	if g.isHidden(doc) {
+		return false
+	}
+
+	if g.conf.DeclarationFilter == nil {
+		return true
+	}
+
+	d := Declaration{
+		Kind: kind,
+		Name: name,
+	}
+
+	if g.pkg != nil {
+		d.Package = g.pkg.ID
+	}
+
+	if doc != nil {
+		d.Doc = doc.Text()
+	}
+
+	return g.conf.DeclarationFilter(d)
+}
+
+// formatDeclName returns the generated name of the specified top level
+// declaration after applying the Config.TypeNameFormatter (if any).
+func (g *PackageGenerator) formatDeclName(kind string, name string) string {
+	if g.conf.TypeNameFormatter == nil {
+		return name
+	}
+
+	return g.conf.TypeNameFormatter(kind, name)
+}
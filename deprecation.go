@@ -0,0 +1,77 @@
+package tygojaPB
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	deprecatedParagraphRegex = regexp.MustCompile(`(?i)^deprecated(?::|\.|$)\s*`)
+	deprecatedVersionRegex   = regexp.MustCompile(`(?i)[.,;]?\s*\(?\b(?:since|as of)\s+(v?\d+(?:\.\d+)*)\)?\.?`)
+)
+
+// extractDeprecation removes the Go "Deprecated:" paragraph from the
+// specified doc lines and returns it as JSDoc tags (see Config.DeprecatedAsJSDoc).
+//
+// For example "Deprecated: use X instead. Since v1.2" will result in:
+//
+//	@deprecated use X instead
+//	@since v1.2
+func extractDeprecation(lines []string) ([]string, []string) {
+	start := -1
+	end := len(lines)
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		if start == -1 {
+			// the deprecation notice must be at the start of a paragraph
+			isParagraphStart := i == 0 || strings.TrimSpace(lines[i-1]) == ""
+			if isParagraphStart && !strings.HasPrefix(line, "\t") && deprecatedParagraphRegex.MatchString(trimmed) {
+				start = i
+			}
+			continue
+		}
+
+		if trimmed == "" {
+			end = i
+			break
+		}
+	}
+
+	if start == -1 {
+		return lines, nil
+	}
+
+	paragraph := make([]string, 0, end-start)
+	for _, line := range lines[start:end] {
+		paragraph = append(paragraph, strings.TrimSpace(line))
+	}
+
+	text := deprecatedParagraphRegex.ReplaceAllString(strings.Join(paragraph, " "), "")
+
+	var version string
+	if match := deprecatedVersionRegex.FindStringSubmatchIndex(text); match != nil {
+		version = text[match[2]:match[3]]
+		text = text[:match[0]] + text[match[1]:]
+
+		// the version could be at the start (eg. "as of 1.2, use X")
+		text = strings.TrimLeft(text, " ,;")
+	}
+
+	tags := []string{strings.TrimSpace("@deprecated " + strings.TrimSpace(text))}
+	if version != "" {
+		tags = append(tags, "@since "+version)
+	}
+
+	// remove the paragraph together with its preceding empty line (if any)
+	if start > 0 && strings.TrimSpace(lines[start-1]) == "" {
+		start--
+	}
+
+	rest := make([]string, 0, len(lines)-(end-start))
+	rest = append(rest, lines[:start]...)
+	rest = append(rest, lines[end:]...)
+
+	return rest, tags
+}
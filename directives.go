@@ -0,0 +1,127 @@
+package tygojaPB
+
+import (
+	"go/ast"
+	"strconv"
+	"strings"
+)
+
+// rawCommentLines returns the trimmed raw comment group lines
+// without the comment markers.
+//
+// Note that unlike ast.CommentGroup.Text(), the directive
+// comments (eg. "//tygoja:internal") are preserved.
+func rawCommentLines(doc *ast.CommentGroup) []string {
+	if doc == nil {
+		return nil
+	}
+
+	var lines []string
+
+	for _, c := range doc.List {
+		text := c.Text
+		if strings.HasPrefix(text, "//") {
+			lines = append(lines, strings.TrimSpace(text[2:]))
+			continue
+		}
+
+		// block comment
+		text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+		for _, line := range strings.Split(text, "\n") {
+			lines = append(lines, strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "*")))
+		}
+	}
+
+	return lines
+}
+
+// isInternal checks whether the specified doc comment has a Config.InternalMarker line.
+func (g *PackageGenerator) isInternal(doc *ast.CommentGroup) bool {
+	if g.conf.InternalMarker == "" {
+		return false
+	}
+
+	return exists(rawCommentLines(doc), g.conf.InternalMarker)
+}
+
+// ignoreDirective is the doc comment directive that excludes the
+// declaration, struct field or method from the generated output.
+const ignoreDirective = "tygoja:ignore"
+
+// isHidden checks whether the declaration with the specified doc comment
+// should be excluded from the generated output.
+func (g *PackageGenerator) isHidden(doc *ast.CommentGroup) bool {
+	return (g.conf.HideInternal && g.isInternal(doc)) || g.hasExcludeMarker(doc) || exists(rawCommentLines(doc), ignoreDirective)
+}
+
+// hasExcludeMarker checks whether the specified doc comment
+// contains any of the Config.ExcludeMarkers.
+func (g *PackageGenerator) hasExcludeMarker(doc *ast.CommentGroup) bool {
+	if len(g.conf.ExcludeMarkers) == 0 || doc == nil {
+		return false
+	}
+
+	text := strings.Join(rawCommentLines(doc), "\n")
+
+	for _, marker := range g.conf.ExcludeMarkers {
+		if marker != "" && strings.Contains(text, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// optionalFromDirective is the func doc comment directive that marks
+// the params from the specified 0-based index as optional
+// (eg. "//tygoja:optional-from=1").
+const optionalFromDirective = "tygoja:optional-from="
+
+// optionalParamsFrom returns the 0-based index of the first optional
+// func param specified with the optionalFromDirective.
+//
+// It returns -1 if the directive is missing or invalid.
+func optionalParamsFrom(doc *ast.CommentGroup) int {
+	for _, line := range rawCommentLines(doc) {
+		if !strings.HasPrefix(line, optionalFromDirective) {
+			continue
+		}
+
+		n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, optionalFromDirective)))
+		if err != nil || n < 0 {
+			return -1
+		}
+
+		return n
+	}
+
+	return -1
+}
+
+// typeDirective is the struct field doc comment directive that
+// overrides the field TS type (eg. "//tygoja:type string").
+const typeDirective = "tygoja:type "
+
+// typeOverride returns the TS type specified with the typeDirective (if any).
+func typeOverride(doc *ast.CommentGroup) (string, bool) {
+	for _, line := range rawCommentLines(doc) {
+		if !strings.HasPrefix(line, typeDirective) {
+			continue
+		}
+
+		if t := strings.TrimSpace(strings.TrimPrefix(line, typeDirective)); t != "" {
+			return t, true
+		}
+	}
+
+	return "", false
+}
+
+// optionalDirective is the struct field doc comment directive
+// that marks the field as optional regardless of its Go type.
+const optionalDirective = "tygoja:optional"
+
+// isOptionalField checks whether the specified field doc comment has the optionalDirective.
+func isOptionalField(doc *ast.CommentGroup) bool {
+	return exists(rawCommentLines(doc), optionalDirective)
+}
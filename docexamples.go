@@ -0,0 +1,154 @@
+package tygojaPB
+
+import (
+	"go/ast"
+	"go/doc"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// docExamples returns the "@example" JSDoc lines of the Go
+// testable examples associated with the specified declaration name
+// (see Config.DocExamples).
+//
+// The name must be in the go/doc examples format, eg.
+// "Foo" for "ExampleFoo" and "Foo_Bar" for "ExampleFoo_Bar".
+func (g *PackageGenerator) docExamples(name string) []string {
+	if !g.conf.DocExamples {
+		return nil
+	}
+
+	if g.examples == nil {
+		g.examples = g.loadDocExamples()
+	}
+
+	var lines []string
+
+	for _, ex := range g.examples[name] {
+		code := exampleCode(g.examplesFset, ex)
+		if code == "" {
+			continue
+		}
+
+		lines = append(lines, "@example")
+
+		if doc := strings.TrimSpace(ex.Doc); doc != "" {
+			lines = append(lines, strings.Split(doc, "\n")...)
+		}
+
+		lines = append(lines, "```go")
+		lines = append(lines, strings.Split(code, "\n")...)
+
+		if ex.Output != "" || ex.EmptyOutput {
+			if ex.Unordered {
+				lines = append(lines, "// Unordered output:")
+			} else {
+				lines = append(lines, "// Output:")
+			}
+			for _, line := range strings.Split(strings.TrimSuffix(ex.Output, "\n"), "\n") {
+				if line != "" {
+					lines = append(lines, "// "+line)
+				}
+			}
+		}
+
+		lines = append(lines, "```")
+	}
+
+	return lines
+}
+
+// loadDocExamples parses the package test files and
+// returns their examples grouped by name.
+func (g *PackageGenerator) loadDocExamples() map[string][]*doc.Example {
+	result := map[string][]*doc.Example{}
+
+	if len(g.pkg.GoFiles) == 0 {
+		return result
+	}
+
+	testFiles, err := filepath.Glob(filepath.Join(filepath.Dir(g.pkg.GoFiles[0]), "*_test.go"))
+	if err != nil || len(testFiles) == 0 {
+		return result
+	}
+
+	g.examplesFset = token.NewFileSet()
+
+	files := make([]*ast.File, 0, len(testFiles))
+	for _, path := range testFiles {
+		f, err := parser.ParseFile(g.examplesFset, path, nil, parser.ParseComments)
+		if err != nil {
+			continue // ignore the invalid test files
+		}
+		files = append(files, f)
+	}
+
+	for _, ex := range doc.Examples(files...) {
+		name := exampleTarget(ex.Name)
+		result[name] = append(result[name], ex)
+	}
+
+	return result
+}
+
+// exampleTarget returns the declaration name of the specified example
+// without its optional lowercase suffix (eg. "Foo" for "Foo_second").
+//
+// Note that doc.Examples doesn't populate the doc.Example.Suffix field.
+func exampleTarget(name string) string {
+	idx := strings.LastIndex(name, "_")
+	if idx < 0 || idx == len(name)-1 {
+		return name
+	}
+
+	if r, _ := utf8.DecodeRuneInString(name[idx+1:]); unicode.IsLower(r) {
+		return name[:idx]
+	}
+
+	return name
+}
+
+// exampleCode returns the formatted body of the specified example
+// (without the enclosing braces and the first indentation level).
+func exampleCode(fset *token.FileSet, ex *doc.Example) string {
+	if ex.Code == nil {
+		return ""
+	}
+
+	// exclude the output comment since it is written separately
+	comments := make([]*ast.CommentGroup, 0, len(ex.Comments))
+	for _, c := range ex.Comments {
+		text := strings.TrimSpace(c.Text())
+		if strings.HasPrefix(text, "Output:") || strings.HasPrefix(strings.ToLower(text), "unordered output:") {
+			continue
+		}
+		comments = append(comments, c)
+	}
+
+	var sb strings.Builder
+	if err := format.Node(&sb, fset, &printer.CommentedNode{Node: ex.Code, Comments: comments}); err != nil {
+		return ""
+	}
+
+	code := sb.String()
+
+	if _, ok := ex.Code.(*ast.BlockStmt); ok {
+		code = strings.TrimSpace(code)
+		code = strings.TrimPrefix(code, "{")
+		code = strings.TrimSuffix(code, "}")
+
+		lines := strings.Split(strings.Trim(code, "\n"), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimPrefix(line, "\t")
+		}
+		code = strings.Join(lines, "\n")
+	}
+
+	return strings.TrimSpace(code)
+}
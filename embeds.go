@@ -0,0 +1,154 @@
+package tygojaPB
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// inlinedEmbedStruct returns the struct type declaration of the specified
+// embedded field if it should be inlined in its parent struct
+// (see Config.InlineUnexportedEmbeds).
+func (g *PackageGenerator) inlinedEmbedStruct(f *ast.Field) (string, *ast.StructType, bool) {
+	if !g.conf.InlineUnexportedEmbeds || len(f.Names) > 0 {
+		return "", nil, false
+	}
+
+	typ, _ := unwrapPointers(f.Type)
+
+	ident, ok := typ.(*ast.Ident)
+	if !ok || ident.IsExported() {
+		return "", nil, false
+	}
+
+	ts := g.localTypeSpec(ident.Name)
+	if ts == nil || ts.TypeParams != nil {
+		return "", nil, false
+	}
+
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok || st.Fields == nil {
+		return "", nil, false
+	}
+
+	return ident.Name, st, true
+}
+
+// localTypeSpec returns the type spec of the specified type name
+// declared in the current package (or nil if not found).
+func (g *PackageGenerator) localTypeSpec(name string) *ast.TypeSpec {
+	for _, file := range g.syntaxFiles() {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == name {
+					return ts
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// namedInterface returns the name of the first non-generic interface
+// declared in the current package with the same definition as the
+// specified anonymous interface literal.
+func (g *PackageGenerator) namedInterface(iface *ast.InterfaceType) (string, bool) {
+	if g.namedInterfaces == nil {
+		g.namedInterfaces = map[string]string{}
+
+		for _, file := range g.syntaxFiles() {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+
+				for _, spec := range gen.Specs {
+					ts, ok := spec.(*ast.TypeSpec)
+					if !ok || ts.TypeParams != nil || ts.Assign.IsValid() {
+						continue
+					}
+
+					if _, ok := ts.Type.(*ast.InterfaceType); !ok {
+						continue
+					}
+
+					def := types.ExprString(ts.Type)
+					if _, ok := g.namedInterfaces[def]; !ok {
+						g.namedInterfaces[def] = ts.Name.Name
+					}
+				}
+			}
+		}
+	}
+
+	name, ok := g.namedInterfaces[types.ExprString(iface)]
+
+	return name, ok
+}
+
+// inlinedEmbedFields returns the promoted fields of the inlined
+// unexported embedded structs (recursively), excluding
+// the ones shadowed by the parent fields.
+func (g *PackageGenerator) inlinedEmbedFields(fields []*ast.Field, own []structField, visited map[string]struct{}) []structField {
+	var result []structField
+
+	existing := make(map[string]struct{}, len(own))
+	for _, sf := range own {
+		existing[sf.name] = struct{}{}
+	}
+
+	for _, f := range fields {
+		name, st, ok := g.inlinedEmbedStruct(f)
+		if !ok {
+			continue
+		}
+
+		if _, ok := visited[name]; ok {
+			continue // self referencing embed
+		}
+		visited[name] = struct{}{}
+
+		promoted := g.resolveOwnStructFields(st.Fields.List)
+		promoted = append(promoted, g.inlinedEmbedFields(st.Fields.List, promoted, visited)...)
+
+		for _, sf := range promoted {
+			if _, ok := existing[sf.name]; ok {
+				continue // shadowed
+			}
+			existing[sf.name] = struct{}{}
+			result = append(result, sf)
+		}
+	}
+
+	return result
+}
+
+// inlinedEmbedMethods returns the exported methods of the inlined
+// unexported embedded structs (recursively).
+func (g *PackageGenerator) inlinedEmbedMethods(fields []*ast.Field, visited map[string]struct{}) []*ast.FuncDecl {
+	var result []*ast.FuncDecl
+
+	for _, f := range fields {
+		name, st, ok := g.inlinedEmbedStruct(f)
+		if !ok {
+			continue
+		}
+
+		if _, ok := visited[name]; ok {
+			continue // self referencing embed
+		}
+		visited[name] = struct{}{}
+
+		result = append(result, g.structMethods(name)...)
+		result = append(result, g.inlinedEmbedMethods(st.Fields.List, visited)...)
+	}
+
+	return result
+}
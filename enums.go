@@ -0,0 +1,170 @@
+package tygojaPB
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// enumMember describes a single typed const written as enum member.
+type enumMember struct {
+	name  string
+	value string
+	doc   *ast.CommentGroup
+}
+
+// enumMembers returns the exported consts of the specified named type
+// in their source order (see Config.EnumStyle).
+//
+// Only the method-less non-generic named types with integer or string
+// underlying type are considered enums (excluding the 64-bit integers
+// with Int64AsBigInt because the TS enum members can't be bigint).
+func (g *PackageGenerator) enumMembers(typeName string) []enumMember {
+	if g.conf.EnumStyle == "" || !g.conf.WithConstants || !g.isEnumType(typeName) {
+		return nil
+	}
+
+	var members []enumMember
+
+	for _, gen := range g.enumConstDecls(typeName) {
+		for _, spec := range gen.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+
+			for _, name := range vs.Names {
+				if !name.IsExported() || g.enumTypeOf(name.Name) != typeName || !g.isTypeAllowed(name.Name) {
+					continue
+				}
+
+				value, ok := g.evaluatedConstValue(name.Name)
+				if !ok {
+					continue
+				}
+
+				doc := vs.Doc
+				if doc == nil && len(gen.Specs) > 1 {
+					doc = vs.Comment
+				}
+
+				if !g.isDeclarationAllowed(DeclarationKindConst, name.Name, doc) {
+					continue
+				}
+
+				members = append(members, enumMember{
+					name:  g.formatDeclName(DeclarationKindConst, name.Name),
+					value: value,
+					doc:   doc,
+				})
+			}
+		}
+	}
+
+	return members
+}
+
+// enumConstDecls returns the const declarations (in their source order)
+// with at least one member of the specified enum type.
+func (g *PackageGenerator) enumConstDecls(typeName string) []*ast.GenDecl {
+	var result []*ast.GenDecl
+
+	for _, file := range g.syntaxFiles() {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+
+		specs:
+			for _, spec := range gen.Specs {
+				vs, ok := spec.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+
+				for _, name := range vs.Names {
+					if g.enumTypeOf(name.Name) == typeName {
+						result = append(result, gen)
+						break specs
+					}
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// isEnumType checks whether the specified current package type could be written as enum.
+func (g *PackageGenerator) isEnumType(typeName string) bool {
+	if g.pkg == nil || g.pkg.Types == nil {
+		return false
+	}
+
+	obj, ok := g.pkg.Types.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok || obj.IsAlias() {
+		return false
+	}
+
+	named, ok := obj.Type().(*types.Named)
+	if !ok || named.NumMethods() > 0 || named.TypeParams().Len() > 0 {
+		return false
+	}
+
+	basic, ok := named.Underlying().(*types.Basic)
+	if !ok || (g.conf.Int64AsBigInt && isGo64BitInt(basic.Name())) {
+		return false
+	}
+
+	return basic.Info()&(types.IsInteger|types.IsString) != 0
+}
+
+// enumTypeOf returns the current package named type of the specified
+// const if it is written as enum member (otherwise returns empty string).
+func (g *PackageGenerator) enumTypeOf(constName string) string {
+	if g.conf.EnumStyle == "" || g.pkg == nil || g.pkg.Types == nil {
+		return ""
+	}
+
+	c, ok := g.pkg.Types.Scope().Lookup(constName).(*types.Const)
+	if !ok {
+		return ""
+	}
+
+	named, ok := c.Type().(*types.Named)
+	if !ok || named.Obj().Pkg() != g.pkg.Types || !g.isTypeAllowed(named.Obj().Name()) || !g.isEnumType(named.Obj().Name()) {
+		return ""
+	}
+
+	return named.Obj().Name()
+}
+
+// writeEnum writes the specified enum declaration, eg.:
+//
+//	const enum Status {
+//	  Active = 0,
+//	  Inactive = 1,
+//	}
+func (g *PackageGenerator) writeEnum(s *strings.Builder, declName string, members []enumMember, depth int) {
+	g.writeStartModifier(s, depth)
+	if g.conf.EnumStyle == EnumStyleConstEnum {
+		s.WriteString("const ")
+	}
+	s.WriteString("enum ")
+	s.WriteString(declName)
+	s.WriteString(" {\n")
+
+	for _, m := range members {
+		g.writeCommentGroup(s, m.doc, depth+1)
+		g.writeIndent(s, depth+1)
+		writePropertyName(s, m.name)
+		s.WriteString(" = ")
+		s.WriteString(m.value)
+		s.WriteString(",\n")
+	}
+
+	g.writeIndent(s, depth)
+	s.WriteString("}\n")
+}
@@ -0,0 +1,241 @@
+package tygojaPB
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// BaseFileName is the name (without extension) of the multi-file
+// output file that contains the heading and the base types declarations.
+const BaseFileName = "_tygoja"
+
+// GenerateFiles executes the generator and produces a separate
+// declaration file for each package namespace.
+//
+// The returned map keys are the file names (eg. "time.d.ts") and
+// the values are their generated content. The heading, globals and base
+// types are written in a separate BaseFileName+".d.ts" file.
+//
+// When DeclarationMode is DeclarationModeModule, each package file
+// imports the base types and the other namespaces so that the cross-package
+// references can be resolved (Config.ModuleName is ignored).
+func (g *Tygoja) GenerateFiles() (map[string]string, error) {
+	if err := g.conf.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	g.reset()
+
+	// the heading must be written before the packages generation
+	// to register the Config.Globals referenced types
+	base := new(strings.Builder)
+	if err := g.writeHeading(base); err != nil {
+		return nil, err
+	}
+
+	// group the generated code by namespace
+	// (the same package could be generated more than once due to the implicit types loading)
+	namespaces := []string{}
+	codes := map[string]*strings.Builder{}
+	err := g.generatePackages(func(pkg generatedPackage) error {
+		sb, ok := codes[pkg.namespace]
+		if !ok {
+			sb = new(strings.Builder)
+			codes[pkg.namespace] = sb
+			namespaces = append(namespaces, pkg.namespace)
+		}
+		sb.WriteString(pkg.code)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(namespaces)
+
+	files := make(map[string]string, len(namespaces)+1)
+
+	g.writeBaseTypes(base)
+	files[BaseFileName+".d.ts"] = base.String()
+
+	isModule := g.conf.DeclarationMode == DeclarationModeModule
+
+	for _, ns := range namespaces {
+		s := new(strings.Builder)
+		s.WriteString("// GENERATED CODE - DO NOT MODIFY BY HAND\n")
+
+		if isModule {
+			s.WriteString("import type { ")
+			s.WriteString(strings.Join(g.baseTypeNames(), ", "))
+			s.WriteString(" } from \"./")
+			s.WriteString(BaseFileName)
+			s.WriteString("\"\n")
+
+			for _, other := range namespaces {
+				if other == ns {
+					continue
+				}
+				s.WriteString("import type { ")
+				s.WriteString(other)
+				s.WriteString(" } from \"./")
+				s.WriteString(other)
+				s.WriteString("\"\n")
+			}
+		}
+
+		s.WriteString(codes[ns].String())
+
+		files[ns+".d.ts"] = s.String()
+	}
+
+	return files, nil
+}
+
+// GenerateBarrel executes the generator and produces an "index.d.ts"
+// barrel content that re-exports every GenerateFiles module, eg.:
+//
+//	export * from "./_tygoja";
+//	export * from "./time";
+//
+// The barrel is meaningful only when DeclarationMode is DeclarationModeModule.
+func (g *Tygoja) GenerateBarrel() (string, error) {
+	files, err := g.GenerateFiles()
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, strings.TrimSuffix(name, ".d.ts"))
+	}
+	sort.Strings(names)
+
+	var s strings.Builder
+
+	s.WriteString("// GENERATED CODE - DO NOT MODIFY BY HAND\n")
+	for _, name := range names {
+		s.WriteString("export * from \"./")
+		s.WriteString(name)
+		s.WriteString("\";\n")
+	}
+
+	return s.String(), nil
+}
+
+// GenerateTree executes the generator and produces a directory
+// structure that mirrors the Go package paths, with a separate
+// "index.d.ts" declaration file for each package
+// (eg. "github.com/example/models/index.d.ts").
+//
+// The returned map keys are the relative file paths and the values
+// are their generated content. The heading, globals and base types
+// are written in a root BaseFileName+".d.ts" file.
+//
+// When DeclarationMode is DeclarationModeModule, each package file
+// imports the base types and the other package namespaces using relative
+// paths so that the tree could be used directly as typedoc "entryPoints".
+// Note that if multiple packages share the same namespace name,
+// only the first one (in path order) is imported.
+func (g *Tygoja) GenerateTree() (map[string]string, error) {
+	if err := g.conf.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	g.reset()
+
+	// the heading must be written before the packages generation
+	// to register the Config.Globals referenced types
+	base := new(strings.Builder)
+	if err := g.writeHeading(base); err != nil {
+		return nil, err
+	}
+
+	// group the generated code by package
+	// (the same package could be generated more than once due to the implicit types loading)
+	paths := []string{}
+	namespaces := map[string]string{}
+	codes := map[string]*strings.Builder{}
+	err := g.generatePackages(func(pkg generatedPackage) error {
+		sb, ok := codes[pkg.id]
+		if !ok {
+			sb = new(strings.Builder)
+			codes[pkg.id] = sb
+			namespaces[pkg.id] = pkg.namespace
+			paths = append(paths, pkg.id)
+		}
+		sb.WriteString(pkg.code)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	files := make(map[string]string, len(paths)+1)
+
+	g.writeBaseTypes(base)
+	files[BaseFileName+".d.ts"] = base.String()
+
+	isModule := g.conf.DeclarationMode == DeclarationModeModule
+
+	for _, p := range paths {
+		s := new(strings.Builder)
+		s.WriteString("// GENERATED CODE - DO NOT MODIFY BY HAND\n")
+
+		if isModule {
+			s.WriteString("import type { ")
+			s.WriteString(strings.Join(g.baseTypeNames(), ", "))
+			s.WriteString(" } from \"")
+			s.WriteString(relativeImportPath(p, BaseFileName))
+			s.WriteString("\"\n")
+
+			imported := map[string]struct{}{namespaces[p]: {}}
+			for _, other := range paths {
+				ns := namespaces[other]
+				if _, ok := imported[ns]; ok {
+					continue
+				}
+				imported[ns] = struct{}{}
+
+				s.WriteString("import type { ")
+				s.WriteString(ns)
+				s.WriteString(" } from \"")
+				s.WriteString(relativeImportPath(p, other+"/index"))
+				s.WriteString("\"\n")
+			}
+		}
+
+		s.WriteString(codes[p].String())
+
+		files[p+"/index.d.ts"] = s.String()
+	}
+
+	return files, nil
+}
+
+// relativeImportPath returns the relative import path of the
+// specified target (relative to the tree root) from the dir directory.
+//
+// For example relativeImportPath("a/b", "a/c/index") returns "../c/index".
+func relativeImportPath(dir string, target string) string {
+	dirParts := strings.Split(strings.Trim(dir, "/"), "/")
+	targetParts := strings.Split(strings.Trim(target, "/"), "/")
+
+	// strip the common prefix (the last target part is always a file)
+	var common int
+	for common < len(dirParts) && common < len(targetParts)-1 && dirParts[common] == targetParts[common] {
+		common++
+	}
+
+	parts := make([]string, 0, len(dirParts)-common+len(targetParts)-common)
+	for range dirParts[common:] {
+		parts = append(parts, "..")
+	}
+	parts = append(parts, targetParts[common:]...)
+
+	if parts[0] != ".." {
+		return "./" + strings.Join(parts, "/")
+	}
+
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,193 @@
+package tygojaPB
+
+import (
+	"go/ast"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Graph describes the generated declarations and the types they reference.
+type Graph struct {
+	// Nodes is the sorted list of the generated declarations.
+	Nodes []GraphNode `json:"nodes"`
+
+	// Edges is the sorted list of the declarations type references.
+	Edges []GraphEdge `json:"edges"`
+}
+
+// GraphNode describes a single generated top level declaration.
+type GraphNode struct {
+	// ID is the unique declaration identifier in the format
+	// "pkgPath.Name" (or "pkgPath.Type.Method" for methods).
+	ID string `json:"id"`
+
+	// Package is the declaration package import path.
+	Package string `json:"package"`
+
+	// Name is the declaration Go name (eg. "Type.Method" for methods).
+	Name string `json:"name"`
+
+	// Kind is the declaration kind (see the DeclarationKind* constants).
+	Kind string `json:"kind"`
+}
+
+// GraphEdge describes a type reference between 2 declarations.
+//
+// Note that To could be an ID of a type without node
+// (eg. a type excluded from the generation or with TypeMappings).
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// graphState holds the collected graph of a single DeclarationGraph run.
+type graphState struct {
+	nodes map[string]GraphNode
+	edges map[GraphEdge]struct{}
+}
+
+// DeclarationGraph executes the generator and returns the graph of the
+// generated declarations and their type references, including the ones
+// to the implicitly generated types from other packages.
+func (g *Tygoja) DeclarationGraph() (*Graph, error) {
+	state := &graphState{
+		nodes: map[string]GraphNode{},
+		edges: map[GraphEdge]struct{}{},
+	}
+
+	g.graph = state
+	defer func() {
+		g.graph = nil
+	}()
+
+	if err := g.GenerateTo(io.Discard); err != nil {
+		return nil, err
+	}
+
+	result := &Graph{
+		Nodes: make([]GraphNode, 0, len(state.nodes)),
+		Edges: make([]GraphEdge, 0, len(state.edges)),
+	}
+
+	for _, n := range state.nodes {
+		result.Nodes = append(result.Nodes, n)
+	}
+	sort.Slice(result.Nodes, func(i, j int) bool {
+		return result.Nodes[i].ID < result.Nodes[j].ID
+	})
+
+	for e := range state.edges {
+		result.Edges = append(result.Edges, e)
+	}
+	sort.Slice(result.Edges, func(i, j int) bool {
+		if result.Edges[i].From != result.Edges[j].From {
+			return result.Edges[i].From < result.Edges[j].From
+		}
+		return result.Edges[i].To < result.Edges[j].To
+	})
+
+	return result, nil
+}
+
+// enterGraphNode registers the specified declaration as graph node
+// and marks it as the source of the type references written until
+// the returned func is called.
+func (g *PackageGenerator) enterGraphNode(kind string, name string) func() {
+	id := g.pkg.ID + "." + name
+
+	if g.graph != nil {
+		g.graph.nodes[id] = GraphNode{
+			ID:      id,
+			Package: g.pkg.ID,
+			Name:    name,
+			Kind:    kind,
+		}
+	}
+
+	prev := g.graphNode
+	g.graphNode = id
+
+	return func() {
+		g.graphNode = prev
+	}
+}
+
+// addGraphEdge registers the type referenced by t (if any)
+// as graph edge of the current graph node.
+//
+// The reference is also collected in the current declaration
+// references (see generateDecl) that are used by the Config.TopoSort.
+func (g *PackageGenerator) addGraphEdge(t ast.Expr) {
+	if g.graphNode == "" || (g.graph == nil && g.references == nil) {
+		return
+	}
+
+	if ident, ok := t.(*ast.Ident); ok {
+		if g.typeParams[ident.Name] > 0 {
+			return // type param
+		}
+	}
+
+	typeName := g.lookupTypeName(t)
+	if typeName == nil || typeName.Pkg() == nil {
+		return // not a package type (eg. builtin)
+	}
+
+	to := typeName.Pkg().Path() + "." + typeName.Name()
+	if to == g.graphNode {
+		return
+	}
+
+	if g.references != nil {
+		g.references[to] = struct{}{}
+	}
+
+	if g.graph != nil {
+		g.graph.edges[GraphEdge{From: g.graphNode, To: to}] = struct{}{}
+	}
+}
+
+// reachableTypes returns the generated declaration names grouped by their
+// package that are transitively referenced by the specified root IDs.
+//
+// The references of the methods are attributed to their receiver type.
+func (graph *Graph) reachableTypes(roots []string) map[string][]string {
+	nodes := make(map[string]GraphNode, len(graph.Nodes))
+	for _, n := range graph.Nodes {
+		nodes[n.ID] = n
+	}
+
+	refs := map[string][]string{}
+	for _, e := range graph.Edges {
+		from := e.From
+		if n, ok := nodes[from]; ok && n.Kind == DeclarationKindMethod {
+			from = n.Package + "." + strings.SplitN(n.Name, ".", 2)[0]
+		}
+		refs[from] = append(refs[from], e.To)
+	}
+
+	visited := map[string]struct{}{}
+	queue := append([]string{}, roots...)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+
+		queue = append(queue, refs[id]...)
+	}
+
+	result := map[string][]string{}
+	for _, n := range graph.Nodes {
+		if _, ok := visited[n.ID]; !ok || n.Kind == DeclarationKindMethod {
+			continue
+		}
+		result[n.Package] = append(result[n.Package], n.Name)
+	}
+
+	return result
+}
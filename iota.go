@@ -2,6 +2,8 @@ package tygojaPB
 
 import (
 	"fmt"
+	"go/constant"
+	"go/types"
 	"strconv"
 	"strings"
 )
@@ -36,3 +38,49 @@ func basicIotaOffsetValueParse(groupType string) (int, error) {
 
 	return int(addValue), nil
 }
+
+// evaluatedConstValue returns the type checker evaluated value
+// of the named package level numeric, string or boolean constant.
+func (g *PackageGenerator) evaluatedConstValue(name string) (string, bool) {
+	if g.pkg == nil || g.pkg.Types == nil {
+		return "", false
+	}
+
+	c, ok := g.pkg.Types.Scope().Lookup(name).(*types.Const)
+	if !ok || c.Val() == nil {
+		return "", false
+	}
+
+	val := c.Val()
+
+	switch val.Kind() {
+	case constant.Int:
+		// the 64-bit integers are written as bigint with Int64AsBigInt
+		if g.conf.Int64AsBigInt {
+			if basic, ok := c.Type().Underlying().(*types.Basic); ok && isGo64BitInt(basic.Name()) {
+				return val.ExactString() + "n", true
+			}
+		}
+		return val.ExactString(), true
+	case constant.String:
+		return jsStringLiteral(constant.StringVal(val)), true
+	case constant.Bool:
+		return val.String(), true
+	case constant.Float:
+		f, _ := constant.Float64Val(val)
+		return strconv.FormatFloat(f, 'g', -1, 64), true
+	}
+
+	return "", false
+}
+
+// isComplexConst checks whether the named package level constant has complex value.
+func (g *PackageGenerator) isComplexConst(name string) bool {
+	if g.pkg == nil || g.pkg.Types == nil {
+		return false
+	}
+
+	c, ok := g.pkg.Types.Scope().Lookup(name).(*types.Const)
+
+	return ok && c.Val() != nil && c.Val().Kind() == constant.Complex
+}
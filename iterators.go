@@ -0,0 +1,71 @@
+package tygojaPB
+
+import (
+	"go/ast"
+	"strings"
+)
+
+// iteratorElements returns the yielded element types of a Go 1.23
+// range-over-func iterator signature, eg.:
+//
+//	func(yield func(T) bool)    -> [T]
+//	func(yield func(K, V) bool) -> [K, V]
+//
+// It returns nil if t is not an iterator func signature.
+func iteratorElements(t *ast.FuncType) []ast.Expr {
+	if t.TypeParams != nil || (t.Results != nil && len(t.Results.List) > 0) {
+		return nil
+	}
+
+	if t.Params == nil || len(t.Params.List) != 1 || len(t.Params.List[0].Names) > 1 {
+		return nil
+	}
+
+	yield, ok := t.Params.List[0].Type.(*ast.FuncType)
+	if !ok || yield.Results == nil || len(yield.Results.List) != 1 || len(yield.Results.List[0].Names) > 1 {
+		return nil
+	}
+
+	if result, ok := yield.Results.List[0].Type.(*ast.Ident); !ok || result.Name != "bool" {
+		return nil
+	}
+
+	var elems []ast.Expr
+	if yield.Params != nil {
+		for _, f := range yield.Params.List {
+			for range max(len(f.Names), 1) {
+				elems = append(elems, f.Type)
+			}
+		}
+	}
+
+	if len(elems) == 0 || len(elems) > 2 {
+		return nil
+	}
+
+	return elems
+}
+
+// writeIterable writes the specified TS iterable type with the provided element types
+// (multiple elements are written as tuple, eg. "Iterable<[K, V]>").
+func (g *PackageGenerator) writeIterable(s *strings.Builder, iterable string, elems []ast.Expr, depth int) {
+	s.WriteString(iterable)
+	s.WriteByte('<')
+
+	if len(elems) > 1 {
+		s.WriteByte('[')
+	}
+
+	for i, elem := range elems {
+		if i > 0 {
+			s.WriteString(", ")
+		}
+		g.writeType(s, elem, depth)
+	}
+
+	if len(elems) > 1 {
+		s.WriteByte(']')
+	}
+
+	s.WriteByte('>')
+}
@@ -0,0 +1,402 @@
+package tygojaPB
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"go/ast"
+	"go/types"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Manifest describes the previously generated top level declarations
+// and it is used to skip the regeneration of the unchanged ones
+// (see Tygoja.GenerateIncremental).
+//
+// The manifest is JSON serializable so that it could be persisted between runs.
+type Manifest struct {
+	// Declarations is a map with the already generated top level declarations
+	// keyed by their package, type filter and name.
+	Declarations map[string]ManifestDeclaration `json:"declarations"`
+}
+
+// ManifestDeclaration describes a single generated top level declaration.
+type ManifestDeclaration struct {
+	// Hash is the checksum of the declaration Go source.
+	Hash string `json:"hash"`
+
+	// Code is the generated TS code of the declaration.
+	Code string `json:"code"`
+
+	// GeneratedTypes is a list with the type names marked as
+	// generated while writing the declaration.
+	GeneratedTypes []string `json:"generatedTypes,omitempty"`
+
+	// UnknownTypes is a list with the referenced types that are
+	// not part of the declaration package types filter.
+	UnknownTypes []string `json:"unknownTypes,omitempty"`
+
+	// References is a list with the IDs (in the format "pkgPath.Name")
+	// of the types referenced by the declaration.
+	References []string `json:"references,omitempty"`
+}
+
+// incrementalState holds the manifests of a single incremental generation.
+type incrementalState struct {
+	prev       Manifest
+	next       Manifest
+	files      map[string][]byte // the cached package files content
+	signatures map[string][]byte // the cached package signatures (see packageSignature)
+}
+
+// GenerateIncremental executes the generator similar to Generate
+// but reuses the generated code of the prev manifest declarations
+// whose Go source hasn't changed.
+//
+// It returns the full generated output (as with Generate) and the new
+// manifest that could be passed to the next GenerateIncremental call.
+//
+// The declaration checksum covers its own source (including its doc comment
+// and the source of its methods) and the signatures of the rest of the package
+// declarations and of the referenced imported types.
+// The Config is not part of the checksum so you should discard
+// the manifest when the Config changes.
+func (g *Tygoja) GenerateIncremental(prev Manifest) (string, Manifest, error) {
+	state := &incrementalState{
+		prev:       prev,
+		next:       Manifest{Declarations: map[string]ManifestDeclaration{}},
+		files:      map[string][]byte{},
+		signatures: map[string][]byte{},
+	}
+
+	g.incremental = state
+	defer func() {
+		g.incremental = nil
+	}()
+
+	result, err := g.Generate()
+	if err != nil {
+		return "", Manifest{}, err
+	}
+
+	return result, state.next, nil
+}
+
+// writeDecl writes the specified top level declaration using the
+// write callback or, if the incremental generation is enabled and
+// the declaration is unchanged, its previously generated code.
+func (g *PackageGenerator) writeDecl(s *strings.Builder, decl ast.Decl, write func(s *strings.Builder)) {
+	if g.incremental == nil {
+		write(s)
+		return
+	}
+
+	key := g.declKey(decl)
+	hash := g.declHash(decl)
+
+	if prev, ok := g.incremental.prev.Declarations[key]; ok && hash != "" && prev.Hash == hash {
+		for _, t := range prev.GeneratedTypes {
+			g.markAsGenerated(t)
+		}
+		for _, t := range prev.UnknownTypes {
+			g.unknownTypes[t] = struct{}{}
+		}
+		if g.references != nil {
+			for _, t := range prev.References {
+				g.references[t] = struct{}{}
+			}
+		}
+
+		s.WriteString(prev.Code)
+
+		g.incremental.next.Declarations[key] = prev
+
+		return
+	}
+
+	// snapshot the tracked types to extract the ones added by the declaration
+	generatedBefore := make(map[string]struct{}, len(g.generatedTypes))
+	for t := range g.generatedTypes {
+		generatedBefore[t] = struct{}{}
+	}
+	unknownBefore := make(map[string]struct{}, len(g.unknownTypes))
+	for t := range g.unknownTypes {
+		unknownBefore[t] = struct{}{}
+	}
+
+	sb := new(strings.Builder)
+	write(sb)
+
+	entry := ManifestDeclaration{
+		Hash: hash,
+		Code: sb.String(),
+	}
+
+	for t := range g.generatedTypes {
+		if _, ok := generatedBefore[t]; !ok {
+			entry.GeneratedTypes = append(entry.GeneratedTypes, t)
+		}
+	}
+	sort.Strings(entry.GeneratedTypes)
+
+	for t := range g.unknownTypes {
+		if _, ok := unknownBefore[t]; !ok {
+			entry.UnknownTypes = append(entry.UnknownTypes, t)
+		}
+	}
+	sort.Strings(entry.UnknownTypes)
+
+	for t := range g.references {
+		entry.References = append(entry.References, t)
+	}
+	sort.Strings(entry.References)
+
+	if hash != "" {
+		g.incremental.next.Declarations[key] = entry
+	}
+
+	s.WriteString(entry.Code)
+}
+
+// declKey returns the manifest key of the specified top level declaration
+// (eg. "github.com/example/pkg[*].Foo.Bar").
+func (g *PackageGenerator) declKey(decl ast.Decl) string {
+	var name string
+
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		name = d.Name.Name
+		if d.Recv != nil && len(d.Recv.List) == 1 {
+			name = receiverName(d.Recv.List[0].Type) + "." + name
+		}
+	case *ast.GenDecl:
+		names := []string{}
+		for _, spec := range d.Specs {
+			switch sp := spec.(type) {
+			case *ast.TypeSpec:
+				names = append(names, sp.Name.Name)
+			case *ast.ValueSpec:
+				for _, n := range sp.Names {
+					names = append(names, n.Name)
+				}
+			}
+		}
+		name = strings.Join(names, ",")
+	}
+
+	key := g.pkg.ID + "[" + strings.Join(g.types, ",") + "]." + name
+
+	// disambiguate declarations with the same name (eg. grouped "_" consts)
+	if g.declKeys == nil {
+		g.declKeys = map[string]int{}
+	}
+	g.declKeys[key]++
+	if n := g.declKeys[key]; n > 1 {
+		key += "#" + strconv.Itoa(n)
+	}
+
+	return key
+}
+
+// declHash returns the checksum of the specified top level declaration
+// and of the rest of the sources that could affect its generated output:
+//   - the declaration source, including its doc comment
+//   - the source of the type methods (for type declarations)
+//   - the declaration doc examples (see Config.DocExamples)
+//   - the current package signature (see packageSignature)
+//   - the signatures of the referenced imported types
+//
+// It returns an empty string if the declaration source can't be read.
+func (g *PackageGenerator) declHash(decl ast.Decl) string {
+	h := sha256.New()
+
+	src, ok := g.nodeSource(decl)
+	if !ok {
+		return ""
+	}
+	h.Write(src)
+
+	var exampleNames []string
+
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Recv != nil && len(d.Recv.List) == 1 {
+			exampleNames = append(exampleNames, receiverName(d.Recv.List[0].Type)+"_"+d.Name.Name)
+		} else {
+			exampleNames = append(exampleNames, d.Name.Name)
+		}
+	case *ast.GenDecl:
+		// include the type methods and enum members since they could affect the
+		// type declaration (eg. func types with methods or Config.StructAsType)
+		for _, spec := range d.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+
+			exampleNames = append(exampleNames, ts.Name.Name)
+
+			for _, fn := range g.typeMethodDecls(ts.Name.Name) {
+				methodSrc, ok := g.nodeSource(fn)
+				if !ok {
+					return ""
+				}
+				h.Write(methodSrc)
+
+				exampleNames = append(exampleNames, ts.Name.Name+"_"+fn.Name.Name)
+			}
+
+			// include the enum members declarations (see Config.EnumStyle)
+			for _, gen := range g.enumConstDecls(ts.Name.Name) {
+				constSrc, ok := g.nodeSource(gen)
+				if !ok {
+					return ""
+				}
+				h.Write(constSrc)
+			}
+		}
+	}
+
+	for _, name := range exampleNames {
+		for _, line := range g.docExamples(name) {
+			h.Write([]byte(line))
+		}
+	}
+
+	h.Write(g.packageSignature())
+
+	// the referenced imported types could also affect the declaration
+	// (eg. the map key type or the embedded struct methods)
+	ast.Inspect(decl, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if tn := g.lookupTypeName(sel); tn != nil {
+				writeObjectSignature(h, tn)
+			}
+		}
+		return true
+	})
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// typeMethodDecls returns the method declarations of the specified current package type.
+func (g *PackageGenerator) typeMethodDecls(typeName string) []*ast.FuncDecl {
+	var result []*ast.FuncDecl
+
+	for _, file := range g.syntaxFiles() {
+		for _, d := range file.Decls {
+			fn, ok := d.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || receiverName(fn.Recv.List[0].Type) != typeName {
+				continue
+			}
+
+			result = append(result, fn)
+		}
+	}
+
+	return result
+}
+
+// packageSignature returns the checksum of the type checker signatures
+// of all current package level declarations (including the type methods).
+//
+// The generated declarations output could depend on the other package
+// declarations (eg. the evaluated constant values, the enum members,
+// the SumTypeInterfaces implementors, the shadowed JS globals, etc.)
+// so any signature change results in regenerating the whole package.
+// The changes only in the functions body and the doc comments are not
+// part of the signature and they affect only their own declaration.
+func (g *PackageGenerator) packageSignature() []byte {
+	if g.pkg.Types == nil {
+		return nil
+	}
+
+	if sig, ok := g.incremental.signatures[g.pkg.ID]; ok {
+		return sig
+	}
+
+	h := sha256.New()
+
+	scope := g.pkg.Types.Scope()
+	for _, name := range scope.Names() {
+		writeObjectSignature(h, scope.Lookup(name))
+	}
+
+	sig := h.Sum(nil)
+
+	g.incremental.signatures[g.pkg.ID] = sig
+
+	return sig
+}
+
+// writeObjectSignature writes the type checker signature of the specified
+// object into w (including the underlying type definition, the constant
+// value and the methods of the named types).
+func writeObjectSignature(w io.Writer, obj types.Object) {
+	io.WriteString(w, types.ObjectString(obj, nil))
+	if c, ok := obj.(*types.Const); ok {
+		io.WriteString(w, " = ")
+		io.WriteString(w, c.Val().ExactString())
+	}
+	io.WriteString(w, "\n")
+
+	tn, ok := obj.(*types.TypeName)
+	if !ok || tn.IsAlias() {
+		return
+	}
+
+	if named, ok := tn.Type().(*types.Named); ok {
+		for i := 0; i < named.NumMethods(); i++ {
+			io.WriteString(w, types.ObjectString(named.Method(i), nil))
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+// nodeSource returns the raw source of the specified top level
+// declaration, including its doc comment and the rest of its last line.
+func (g *PackageGenerator) nodeSource(decl ast.Decl) ([]byte, bool) {
+	if g.pkg.Fset == nil {
+		return nil, false
+	}
+
+	start := decl.Pos()
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Doc != nil {
+			start = d.Doc.Pos()
+		}
+	case *ast.GenDecl:
+		if d.Doc != nil {
+			start = d.Doc.Pos()
+		}
+	}
+
+	startPos := g.pkg.Fset.Position(start)
+	endPos := g.pkg.Fset.Position(decl.End())
+
+	content, ok := g.incremental.files[startPos.Filename]
+	if !ok {
+		var err error
+		content, err = os.ReadFile(startPos.Filename)
+		if err != nil {
+			return nil, false
+		}
+		g.incremental.files[startPos.Filename] = content
+	}
+
+	if startPos.Offset < 0 || endPos.Offset > len(content) || startPos.Offset > endPos.Offset {
+		return nil, false
+	}
+
+	// include the trailing line comment (if any)
+	end := endPos.Offset
+	for end < len(content) && content[end] != '\n' {
+		end++
+	}
+
+	return content[startPos.Offset:end], true
+}
@@ -0,0 +1,178 @@
+package tygojaPB
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// narrowedFuncType returns a copy of the function declaration type with
+// its interface return type replaced by the concrete type that the function
+// body always returns (see Config.NarrowInterfaceReturns).
+//
+// To keep it conservative, the narrowing is applied only for functions with
+// a single interface result (optionally followed by an error) and
+// whose return statements are all composite literals of the same type
+// (eg. "return &Foo{}, nil").
+//
+// It returns the original declaration type if the narrowing is not possible.
+func (g *PackageGenerator) narrowedFuncType(decl *ast.FuncDecl) *ast.FuncType {
+	ft := decl.Type
+
+	if decl.Body == nil || ft.Results == nil {
+		return ft
+	}
+
+	results := ft.Results.List
+	if len(results) == 0 || len(results) > 2 || len(results[0].Names) > 1 {
+		return ft
+	}
+
+	if len(results) == 2 {
+		if errIdent, ok := results[1].Type.(*ast.Ident); !ok || errIdent.Name != "error" || len(results[1].Names) > 1 {
+			return ft
+		}
+	}
+
+	if !g.isInterfaceExpr(results[0].Type) {
+		return ft
+	}
+
+	var concrete ast.Expr
+	var concreteStr string
+	narrowable := true
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if !narrowable {
+			return false
+		}
+
+		switch x := n.(type) {
+		case *ast.FuncLit:
+			return false // ignore the nested function returns
+		case *ast.ReturnStmt:
+			if len(x.Results) != len(results) {
+				narrowable = false // eg. naked return
+				return false
+			}
+
+			expr, ok := compositeLitType(x.Results[0])
+			if !ok {
+				narrowable = false
+				return false
+			}
+
+			str := types.ExprString(expr)
+			if concreteStr != "" && concreteStr != str {
+				narrowable = false
+				return false
+			}
+
+			concrete = expr
+			concreteStr = str
+		}
+
+		return true
+	})
+
+	if !narrowable || concrete == nil {
+		return ft
+	}
+
+	// shallow copy to avoid modifying the original AST
+	newResults := make([]*ast.Field, len(results))
+	copy(newResults, results)
+	newResults[0] = &ast.Field{Names: results[0].Names, Type: concrete}
+
+	newType := *ft
+	newType.Results = &ast.FieldList{List: newResults}
+
+	return &newType
+}
+
+// compositeLitType returns the type of a "T{...}" or "&T{...}" expression.
+func compositeLitType(expr ast.Expr) (ast.Expr, bool) {
+	var isPointer bool
+
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		expr = u.X
+		isPointer = true
+	}
+
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok || lit.Type == nil {
+		return nil, false
+	}
+
+	switch lit.Type.(type) {
+	case *ast.Ident, *ast.SelectorExpr:
+	default:
+		return nil, false
+	}
+
+	if isPointer {
+		return &ast.StarExpr{X: lit.Type}, true
+	}
+
+	return lit.Type, true
+}
+
+// isInterfaceExpr checks whether the specified type expression
+// is an interface type (eg. "any", "Reader", "io.Reader").
+func (g *PackageGenerator) isInterfaceExpr(expr ast.Expr) bool {
+	switch t := expr.(type) {
+	case *ast.InterfaceType:
+		return true
+	case *ast.Ident:
+		if t.Name == "any" {
+			return true
+		}
+	}
+
+	typeName := g.lookupTypeName(expr)
+	if typeName == nil {
+		return false
+	}
+
+	_, ok := typeName.Type().Underlying().(*types.Interface)
+
+	return ok
+}
+
+// lookupTypeName returns the type checker object of the specified
+// current package or imported package named type reference
+// (eg. "User" or "models.User").
+//
+// Returns nil if the type couldn't be resolved.
+func (g *PackageGenerator) lookupTypeName(expr ast.Expr) *types.TypeName {
+	if g.pkg == nil {
+		return nil
+	}
+
+	var obj types.Object
+
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if g.pkg.Types != nil {
+			obj = g.pkg.Types.Scope().Lookup(t.Name)
+		}
+	case *ast.SelectorExpr:
+		x, ok := t.X.(*ast.Ident)
+		if !ok {
+			return nil
+		}
+		for path, aliases := range g.imports {
+			if !exists(aliases, x.Name) {
+				continue
+			}
+			if imported, ok := g.pkg.Imports[path]; ok && imported.Types != nil {
+				obj = imported.Types.Scope().Lookup(t.Sel.Name)
+			}
+			break
+		}
+	}
+
+	typeName, _ := obj.(*types.TypeName)
+
+	return typeName
+}
@@ -2,8 +2,12 @@ package tygojaPB
 
 import (
 	"go/ast"
+	"go/doc"
 	"go/token"
+	"go/types"
+	"regexp"
 	"strings"
+	"text/template"
 
 	"golang.org/x/tools/go/packages"
 )
@@ -17,6 +21,40 @@ type PackageGenerator struct {
 	generatedTypes map[string]struct{}
 	unknownTypes   map[string]struct{}
 	imports        map[string][]string // path -> []names/aliases
+
+	dotImports  []*packages.Package // the dot imported packages (eg. import . "models")
+	inlineDepth int                 // the current anonymous struct nesting level
+	typeParams  map[string]int      // the current in scope type parameter names
+
+	// the current method receiver type parameter names mapped
+	// to the type parameter names of the receiver type declaration
+	typeParamRenames map[string]string
+
+	// the currently inlined named types (see Config.InlineNamedTypes)
+	inlinedNamedTypes map[string]struct{}
+
+	// the expression currently written by a Config.TypeHandler with the builtin handling
+	handledType ast.Expr
+
+	// the explicit "this" param type of the currently written named interface methods
+	interfaceThisType string
+
+	linkRegex       *regexp.Regexp    // the lazily initialized Config.LinkifyDocs type names matcher
+	namedInterfaces map[string]string // the lazily initialized named interfaces by their definition
+
+	mapTemplate *template.Template // the lazily parsed Config.MapTypeTemplate
+
+	examples     map[string][]*doc.Example // the lazily loaded Config.DocExamples grouped by name
+	examplesFset *token.FileSet            // the examples test files set
+
+	warnings []Warning // the collected generation warnings
+
+	incremental *incrementalState // non-nil during GenerateIncremental
+	declKeys    map[string]int    // the manifest declaration keys usage counter
+
+	graph      *graphState         // non-nil during DeclarationGraph
+	graphNode  string              // the currently written graph node id
+	references map[string]struct{} // the type references of the currently generated declaration
 }
 
 // Generate generates the typings for a single package.
@@ -25,12 +63,25 @@ func (g *PackageGenerator) Generate() (string, error) {
 
 	namespace := packageNameFromPath(g.pkg.ID)
 
+	files := g.syntaxFiles()
+
 	s.WriteString("\n")
-	for _, f := range g.pkg.Syntax {
+	var hasPackageDoc bool
+	for _, f := range files {
 		if f.Doc == nil || len(f.Doc.List) == 0 {
 			continue
 		}
-		g.writeCommentGroup(s, f.Doc, 0)
+
+		var extraLines []string
+		if g.conf.PackageDocumentation && !hasPackageDoc {
+			extraLines = append(extraLines, "@packageDocumentation")
+			hasPackageDoc = true
+		}
+
+		g.writeCommentGroup(s, f.Doc, 0, extraLines...)
+	}
+	if g.conf.DeclarationMode == DeclarationModeAmbientGlobal {
+		s.WriteString("declare ")
 	}
 	g.writeStartModifier(s, 0)
 	s.WriteString("namespace ")
@@ -39,15 +90,22 @@ func (g *PackageGenerator) Generate() (string, error) {
 
 	// register the aliased imports within the package namespace
 	// (see https://www.typescriptlang.org/docs/handbook/namespaces.html#aliases)
+	var decls []*generatedDecl
+
 	loadedAliases := map[string]struct{}{}
-	for _, file := range g.pkg.Syntax {
+	for _, file := range files {
 		for _, imp := range file.Imports {
 			path := strings.Trim(imp.Path.Value, `"' `)
 
 			pgkName := packageNameFromPath(path)
 			alias := pgkName
 
-			if imp.Name != nil && imp.Name.Name != "" && imp.Name.Name != "_" {
+			// dot imported package (the bare identifiers are resolved later in writeType)
+			if imp.Name != nil && imp.Name.Name == "." {
+				if dotPkg, ok := g.pkg.Imports[path]; ok && !exists(g.dotImports, dotPkg) {
+					g.dotImports = append(g.dotImports, dotPkg)
+				}
+			} else if imp.Name != nil && imp.Name.Name != "" && imp.Name.Name != "_" {
 				alias = imp.Name.Name
 
 				if _, ok := loadedAliases[alias]; ok {
@@ -78,14 +136,18 @@ func (g *PackageGenerator) Generate() (string, error) {
 		ast.Inspect(file, func(n ast.Node) bool {
 			switch x := n.(type) {
 			case *ast.FuncDecl: // FuncDecl can be package level function or struct method
-				g.writeFuncDecl(s, x, 1)
+				decls = append(decls, g.generateDecl(x, func(s *strings.Builder) {
+					g.writeFuncDecl(s, x, 1)
+				}))
 				return false
 			case *ast.GenDecl: // GenDecl can be an import, type, var, or const expression
 				if x.Tok == token.VAR || x.Tok == token.IMPORT {
 					return false // ignore variables and import statements for now
 				}
 
-				g.writeGroupDecl(s, x, 1)
+				decls = append(decls, g.generateDecl(x, func(s *strings.Builder) {
+					g.writeGroupDecl(s, x, 1)
+				}))
 				return false
 			}
 
@@ -93,7 +155,60 @@ func (g *PackageGenerator) Generate() (string, error) {
 		})
 	}
 
+	if g.conf.TopoSort {
+		decls = topoSortDecls(g.pkg.ID, decls)
+	}
+
+	for _, d := range decls {
+		s.WriteString(d.code)
+	}
+
 	s.WriteString("}\n")
 
 	return s.String(), nil
 }
+
+// syntaxFiles returns the package syntax trees
+// of the files allowed by the Config.FileFilter (if any).
+func (g *PackageGenerator) syntaxFiles() []*ast.File {
+	if g.conf.FileFilter == nil {
+		return g.pkg.Syntax
+	}
+
+	result := make([]*ast.File, 0, len(g.pkg.Syntax))
+
+	for _, file := range g.pkg.Syntax {
+		if g.conf.FileFilter(g.pkg.Fset.Position(file.Pos()).Filename) {
+			result = append(result, file)
+		}
+	}
+
+	return result
+}
+
+// dotImportNamespace returns the namespace of the dot imported
+// package that declares the specified exported type name.
+//
+// It returns false if the name is declared in the current package
+// or none of the dot imported packages declares it.
+func (g *PackageGenerator) dotImportNamespace(name string) (string, bool) {
+	if len(g.dotImports) == 0 || !ast.IsExported(name) {
+		return "", false
+	}
+
+	if g.pkg.Types != nil && g.pkg.Types.Scope().Lookup(name) != nil {
+		return "", false
+	}
+
+	for _, dotPkg := range g.dotImports {
+		if dotPkg.Types == nil {
+			continue
+		}
+
+		if _, ok := dotPkg.Types.Scope().Lookup(name).(*types.TypeName); ok {
+			return packageNameFromPath(dotPkg.ID), true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,122 @@
+package tygojaPB
+
+import (
+	"go/types"
+	"regexp"
+	"strings"
+)
+
+var (
+	seeAlsoLineRegex      = regexp.MustCompile(`^(?i:see also):?\s+(.+?)\.?$`)
+	seeAlsoSeparatorRegex = regexp.MustCompile(`\s*,\s*(?:and\s+|or\s+)?|\s+(?:and|or)\s+`)
+	seeAlsoRefRegex       = regexp.MustCompile(`^\[?([A-Z]\w*(?:\.[A-Z]\w*)?)\]?$`)
+)
+
+// extractSeeAlso removes the "See also ..." doc lines that reference only
+// generated declarations of the current package and returns them
+// as JSDoc "@see" tags (see Config.SeeAlsoAsJSDoc).
+//
+// For example "See also [Foo] and Bar.Baz." will result in:
+//
+//	@see {@link Foo}
+//	@see {@link Bar.Baz}
+//
+// The lines with at least one unresolved reference are left unchanged.
+func (g *PackageGenerator) extractSeeAlso(lines []string) ([]string, []string) {
+	var tags []string
+
+	result := make([]string, 0, len(lines))
+
+	for i, line := range lines {
+		match := seeAlsoLineRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil || strings.HasPrefix(line, "\t") {
+			result = append(result, line)
+			continue
+		}
+
+		var links []string
+		for _, ref := range seeAlsoSeparatorRegex.Split(match[1], -1) {
+			link, ok := g.seeAlsoLink(ref)
+			if !ok {
+				links = nil
+				break
+			}
+			links = append(links, link)
+		}
+
+		if len(links) == 0 {
+			result = append(result, line)
+			continue
+		}
+
+		for _, link := range links {
+			tags = append(tags, "@see {@link "+link+"}")
+		}
+
+		// remove the preceding empty line of a single line paragraph
+		isParagraphEnd := i == len(lines)-1 || strings.TrimSpace(lines[i+1]) == ""
+		if isParagraphEnd && len(result) > 0 && strings.TrimSpace(result[len(result)-1]) == "" {
+			result = result[:len(result)-1]
+		}
+	}
+
+	return result, tags
+}
+
+// seeAlsoLink resolves the specified "Name" or "Type.Method" reference
+// to its generated TS declaration name.
+func (g *PackageGenerator) seeAlsoLink(ref string) (string, bool) {
+	match := seeAlsoRefRegex.FindStringSubmatch(ref)
+	if match == nil || g.pkg == nil || g.pkg.Types == nil {
+		return "", false
+	}
+
+	name, method, _ := strings.Cut(match[1], ".")
+
+	if !g.isTypeAllowed(name) {
+		return "", false
+	}
+
+	obj := g.pkg.Types.Scope().Lookup(name)
+
+	if method != "" {
+		tn, ok := obj.(*types.TypeName)
+		if !ok {
+			return "", false
+		}
+
+		if fn, ok := lookupMethod(tn.Type(), method); !ok || !fn.Exported() {
+			return "", false
+		}
+
+		if g.conf.MethodNameFormatter != nil {
+			method = g.conf.MethodNameFormatter(method)
+		}
+
+		return g.formatDeclName(DeclarationKindType, name) + "." + method, true
+	}
+
+	switch obj.(type) {
+	case *types.TypeName:
+		return g.formatDeclName(DeclarationKindType, name), true
+	case *types.Func:
+		if g.conf.WithPackageFunctions {
+			return g.formatDeclName(DeclarationKindFunc, name), true
+		}
+	case *types.Const:
+		if g.conf.WithConstants {
+			return g.formatDeclName(DeclarationKindConst, name), true
+		}
+	}
+
+	return "", false
+}
+
+// lookupMethod returns the method with the specified name of t or *t.
+func lookupMethod(t types.Type, name string) (*types.Func, bool) {
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, name)
+
+	fn, ok := obj.(*types.Func)
+
+	return fn, ok
+}
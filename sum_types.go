@@ -0,0 +1,83 @@
+package tygojaPB
+
+import (
+	"go/types"
+	"strconv"
+	"strings"
+)
+
+// isSumTypeInterface checks whether the provided interface name
+// of the current package is registered in Config.SumTypeInterfaces.
+func (g *PackageGenerator) isSumTypeInterface(name string) bool {
+	return exists(g.conf.SumTypeInterfaces, g.pkg.ID+"."+name)
+}
+
+// sumTypeImplementors returns the names of all generated struct types
+// from the current package that implements the specified interface
+// (either with value or pointer receiver).
+func (g *PackageGenerator) sumTypeImplementors(interfaceName string) []string {
+	if g.pkg.Types == nil {
+		return nil
+	}
+
+	scope := g.pkg.Types.Scope()
+
+	ifaceObj, ok := scope.Lookup(interfaceName).(*types.TypeName)
+	if !ok {
+		return nil
+	}
+
+	iface, ok := ifaceObj.Type().Underlying().(*types.Interface)
+	if !ok {
+		return nil
+	}
+
+	var result []string
+
+	// note: the scope names are already sorted
+	for _, name := range scope.Names() {
+		obj, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || obj.IsAlias() || !g.isTypeAllowed(name) {
+			continue
+		}
+
+		if _, ok := obj.Type().Underlying().(*types.Struct); !ok {
+			continue
+		}
+
+		if types.Implements(obj.Type(), iface) || types.Implements(types.NewPointer(obj.Type()), iface) {
+			result = append(result, name)
+		}
+	}
+
+	return result
+}
+
+// writeSumType writes a discriminated union type alias
+// from all implementations of the specified interface.
+//
+// eg. "type ResultUnion = (Success & { type: "Success" }) | (Failure & { type: "Failure" })"
+func (g *PackageGenerator) writeSumType(s *strings.Builder, interfaceName string, depth int) {
+	implementors := g.sumTypeImplementors(interfaceName)
+	if len(implementors) == 0 {
+		return
+	}
+
+	g.writeStartModifier(s, depth)
+	s.WriteString("type ")
+	s.WriteString(g.formatDeclName(DeclarationKindType, interfaceName))
+	s.WriteString("Union = ")
+
+	for i, name := range implementors {
+		if i > 0 {
+			s.WriteString(" | ")
+		}
+		s.WriteString("(")
+		s.WriteString(g.formatDeclName(DeclarationKindType, name))
+		s.WriteString(" & { ")
+		s.WriteString(g.conf.SumTypeDiscriminator)
+		s.WriteString(": ")
+		s.WriteString(strconv.Quote(name))
+		s.WriteString(" })")
+	}
+}
@@ -34,3 +34,12 @@ type InterfaceB interface {
 
 	CustomMethod() time.Time
 }
+
+// generic interface with type params referenced in the methods
+type Store[K comparable, V any] interface {
+	Get(key K) (V, error)
+
+	Each(fn func(key K, value V) bool)
+
+	Values() []V
+}
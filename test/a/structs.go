@@ -47,3 +47,52 @@ type StructC[A string, B, C any] struct {
 func (s *StructC[A, B, C]) Method4(arg1 A) (a B, b C, c error) {
 	return
 }
+
+// ID comment
+type ID string
+
+// Repo combines generics, pointer receiver and variadic method params
+type Repo[T any] struct{}
+
+// Repo.Find comment
+func (r *Repo[T]) Find(ids ...ID) ([]T, error) {
+	return nil, nil
+}
+
+// Pair with renamed and blank receiver type params
+type Pair[K comparable, V, _ any] struct {
+	Key   K
+	Value V
+}
+
+// Pair.Get comment
+func (p Pair[A, B, _]) Get(key A) B {
+	return p.Value
+}
+
+// Padded with blank padding fields
+type Padded struct {
+	_ [4]byte
+	X int
+	_ string
+}
+
+// Padded.Set with blank params
+func (p *Padded) Set(_ int, x int, _ string) {}
+
+// List generic struct
+type List[T any] struct {
+	Items []T
+}
+
+// Result generic struct
+type Result[T any] struct {
+	Data  T
+	Error string
+}
+
+// Nested with nested generic instantiations
+type Nested struct {
+	Result  Result[List[ID]]
+	Mapping Pair[string, List[int], Result[List[ID]]]
+}
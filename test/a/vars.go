@@ -2,7 +2,10 @@
 // lorem ipsum dolor...
 package a
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // -------------------------------------------------------------------
 // variables (note: currently are ignored)
@@ -45,6 +48,9 @@ const (
 	ConstC2
 )
 
+// type conversion
+const ConstD = time.Duration(5)
+
 // -------------------------------------------------------------------
 // type alias with methods
 // -------------------------------------------------------------------
@@ -66,6 +72,28 @@ func (s SliceAlias[T]) funcC(argA int, argB ...string) (a T, b int, c error) {
 	return
 }
 
+// -------------------------------------------------------------------
+// named slice with exported methods
+// -------------------------------------------------------------------
+
+// IDs is a list of record ids.
+type IDs []string
+
+// Contains checks whether the list contains the specified id.
+func (ids IDs) Contains(id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Join returns the ids as a single string.
+func (ids IDs) Join(sep string) string {
+	return strings.Join(ids, sep)
+}
+
 // -------------------------------------------------------------------
 // function type
 // -------------------------------------------------------------------
@@ -1,6 +1,8 @@
 // package b
 package b
 
+import "io"
+
 func func0() {}
 
 // single comment
@@ -49,3 +51,64 @@ func Func9() (b, c string) {
 func Func10() (a int, b, c string) {
 	return
 }
+
+// function with higher-order function param and result
+func Func11(cb func() func()) func() func(int) error {
+	return nil
+}
+
+// function with byte slice results
+func Func12() ([]byte, error) {
+	return nil, nil
+}
+
+// function with multiple byte slice results
+func Func13(data []byte) ([]byte, *[]byte) {
+	return nil, nil
+}
+
+// function with error only result
+func Func14() error {
+	return nil
+}
+
+// function with shortened error results
+func Func15() (a, b error) {
+	return
+}
+
+// function with TS reserved param names
+func Func16(true bool, null string, eval int, arguments ...any) {}
+
+// function with named interface variadic params
+func Func17(errs ...error) {}
+
+// function with qualified interface variadic params
+func Func18(readers ...io.Reader) {}
+
+// function with named results
+func Func19(a, b int) (q, r int) {
+	return
+}
+
+// function with named result and error
+func Func20() (v int, err error) {
+	return
+}
+
+// function with named results of the params types (Go disallows results with the params names)
+func Func21(a int, b string) (b2 string, a2 int, err error) {
+	return
+}
+
+// function with long params list
+func Func22(firstArgument string, secondArgument int, thirdArgument map[string]int, fourthArgument []string, rest ...bool) {
+}
+
+// function with related declarations
+//
+// See also [Func1], Func2 and Ignored.Method.
+func Func23() {}
+
+// function with empty interface variadic params
+func Func24(format string, args ...interface{}) {}
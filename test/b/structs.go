@@ -0,0 +1,105 @@
+package b
+
+import (
+	"time"
+
+	"github.com/hanzoai/tygojaPB/test/a"
+)
+
+// struct with qualified generic fields from another package
+type Container struct {
+	Repos []a.Repo[a.ID]
+	Pair  *a.Pair[string, a.StructC[string, int, bool], any]
+}
+
+// struct with nil-able map and slice fields
+type Collections struct {
+	Map      map[string]int
+	MapPtr   *map[string]int
+	Slice    []int
+	SlicePtr *[]int
+}
+
+// struct embedding a pointer to a struct from another package
+type Layered struct {
+	*a.StructB[int]
+	Name string
+}
+
+// generic struct embedding a pointer to a generic struct from another package
+type LayeredOf[T any] struct {
+	*a.Repo[T]
+	Name string
+}
+
+// struct with fields type overrides
+type Overrides struct {
+	// custom marshaled field
+	//tygoja:type string
+	Custom a.Pair[int, int, int]
+
+	//tygoja:type number | undefined
+	Optional *int
+}
+
+// struct with sometimes present fields
+type Model struct {
+	Id string
+
+	// the relations are loaded only on demand
+	//tygoja:optional
+	Expand map[string]any
+}
+
+// struct with ignored field and method
+type Ignored struct {
+	Visible string
+
+	//tygoja:ignore
+	Secret string
+}
+
+// Visible method
+func (Ignored) Method() {}
+
+//tygoja:ignore
+func (Ignored) IgnoredMethod() {}
+
+// interface with ignored method
+type IgnoredInterface interface {
+	Method()
+
+	//tygoja:ignore
+	IgnoredMethod()
+}
+
+// struct with duration fields (see Config.DurationType)
+type Job struct {
+	Timeout time.Duration
+	Retry   *time.Duration
+}
+
+// Wait method with duration params
+func (j Job) Wait(d time.Duration, extra ...time.Duration) time.Duration {
+	return d
+}
+
+// struct with pointer elements
+type Registry struct {
+	List []*Model
+	Map  map[string]*Model
+}
+
+// Add method with pointer variadic params
+func (r *Registry) Add(models ...*Model) {}
+
+// struct with multiple pointer levels
+type Pointers struct {
+	Double   **Model
+	SlicePtr *[]int
+}
+
+// Set method with multiple pointer levels params
+func (p *Pointers) Set(double **Model, slicePtr *[]int) ***Model {
+	return nil
+}
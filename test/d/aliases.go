@@ -0,0 +1,17 @@
+//go:build go1.24
+
+package d
+
+// note: the build constraint is required for the generic type aliases
+
+// KeyValue generic type alias with inline struct
+type KeyValue[A, B any] = struct {
+	First  A
+	Second B
+}
+
+// PairAlias type alias
+type PairAlias = Pair
+
+// Entries generic type alias
+type Entries[T any] = []KeyValue[string, T]
@@ -0,0 +1,11 @@
+package d
+
+// Arrays with nested slices and slices of pointers
+type Arrays struct {
+	Matrix   [][]int
+	Cube     [][][]float64
+	Pointers []*Pair
+	Nested   [][]*Pair
+	Funcs    []func() int
+	Fixed    [2][]string
+}
@@ -0,0 +1,30 @@
+package d
+
+// Email is a named string
+type Email string
+
+// Active is a named bool
+type Active bool
+
+// Tags is a named slice
+type Tags []string
+
+// Labels is a named map
+type Labels map[string]Email
+
+// Profile is a named struct
+type Profile struct {
+	Bio string
+}
+
+// Account with named basic, composite and struct types
+type Account struct {
+	Email    Email
+	Active   Active
+	Verified bool
+	Flags    []bool
+	Name     string
+	Tags     Tags
+	Labels   Labels
+	Profile  *Profile
+}
@@ -0,0 +1,14 @@
+package d
+
+// Counter with 64-bit integer fields
+type Counter struct {
+	Total   int64
+	Max     uint64
+	Small   int32
+	ByTotal map[int64]string
+}
+
+// Add with 64-bit integer params
+func (c *Counter) Add(delta int64, items ...uint64) int64 {
+	return c.Total
+}
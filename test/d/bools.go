@@ -0,0 +1,7 @@
+package d
+
+// Enabled is a boolean constant
+const Enabled = true
+
+// Disabled is a boolean constant expression
+const Disabled = !Enabled
@@ -0,0 +1,9 @@
+package d
+
+// Buffer with variadic byte params
+type Buffer struct{}
+
+// Write with variadic byte params
+func (b *Buffer) Write(data ...byte) int {
+	return len(data)
+}
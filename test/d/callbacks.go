@@ -0,0 +1,17 @@
+package d
+
+// Emitter with callback params
+type Emitter struct{}
+
+// On with nested callback param types
+//
+//tygoja:optional-from=1
+func (e *Emitter) On(event string, handler func(data string, extra int) error, priority int) {}
+
+// Listener interface with callback params
+type Listener interface {
+	// Listen with nested callback param types
+	//
+	//tygoja:optional-from=1
+	Listen(event string, handler func(data string) bool, once bool)
+}
@@ -0,0 +1,18 @@
+package d
+
+// Event sent through the channels
+type Event struct {
+	Name string
+}
+
+// Pipes with all channel directions
+type Pipes struct {
+	Both    chan Event
+	Receive <-chan *Event
+	Send    chan<- []Event
+}
+
+// Subscribe returns a receive-only channel
+func (p *Pipes) Subscribe(topic string) <-chan Event {
+	return nil
+}
@@ -0,0 +1,27 @@
+package d
+
+// single field struct collapsed to its field type
+type Wrapper struct {
+	Value []string
+}
+
+// single field generic struct
+type GenericWrapper[T any] struct {
+	Value T
+}
+
+// multiple fields struct that is not collapsed
+type Pair struct {
+	Key   string
+	Value int
+}
+
+// single field struct with methods that is not collapsed
+type WrapperWithMethods struct {
+	Value string
+}
+
+// String method
+func (w WrapperWithMethods) String() string {
+	return w.Value
+}
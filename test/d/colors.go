@@ -0,0 +1,18 @@
+package d
+
+// Color is a string typed const group
+type Color string
+
+// color constants
+const (
+	// Red documented member
+	Red Color = "red"
+
+	Green Color = "green" // Green trailing comment member
+
+	// Blue without trailing comment
+	Blue Color = "blue"
+)
+
+// DefaultColor ungrouped constant
+const DefaultColor = Red
@@ -0,0 +1,13 @@
+package d
+
+// Signal with complex number fields
+type Signal struct {
+	Sample  complex128
+	Samples []complex64
+	Peak    *complex128
+}
+
+// Scale returns the scaled sample
+func (s *Signal) Scale(factor complex128) complex128 {
+	return s.Sample * factor
+}
@@ -0,0 +1,21 @@
+package d
+
+import "github.com/hanzoai/tygojaPB/test/d/keys"
+
+// Priority is a conversion target type
+type Priority int
+
+// LowPriority is an untyped constant
+const LowPriority = 1
+
+// converted constants
+const (
+	// HighPriority converts another constant
+	HighPriority = Priority(LowPriority + 1)
+
+	// DefaultCode converts to a type from another package
+	DefaultCode = keys.Code("default")
+
+	// Parenthesized conversion
+	MaxPriority = (Priority)(10)
+)
@@ -0,0 +1,22 @@
+package d
+
+// OldClient is replaced by Client.
+//
+// Deprecated: use Client instead. Since v1.2
+type OldClient struct {
+	// Timeout in seconds.
+	//
+	// Deprecated: as of 1.3, use Deadline.
+	Timeout int
+
+	Deadline string
+}
+
+// Ping checks the connection.
+//
+// Deprecated: no longer needed.
+//
+// Kept only for compatibility.
+func (c *OldClient) Ping() bool {
+	return true
+}
@@ -0,0 +1,9 @@
+// Package documented has package doc comments in multiple files.
+//
+// Only the first one is tagged as package documentation.
+package documented
+
+// Item of the documented package
+type Item struct {
+	ID string
+}
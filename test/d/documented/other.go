@@ -0,0 +1,7 @@
+// Package documented second doc comment.
+package documented
+
+// Other of the documented package
+type Other struct {
+	Item Item
+}
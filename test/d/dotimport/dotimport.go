@@ -0,0 +1,17 @@
+package dotimport
+
+// note: separate package because of the dot import
+
+import . "github.com/hanzoai/tygojaPB/test/d"
+
+// Local type declared in the current package
+type Local struct {
+	Value string
+}
+
+// WithDotImport with bare identifiers from a dot imported package
+type WithDotImport struct {
+	Pair    Pair
+	Wrapper *Wrapper
+	Local   Local
+}
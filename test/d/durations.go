@@ -0,0 +1,15 @@
+package d
+
+import "time"
+
+// Timeout with duration fields
+type Timeout struct {
+	Delay   time.Duration
+	Retries []time.Duration
+	Max     *time.Duration
+}
+
+// Extend returns the extended delay
+func (t *Timeout) Extend(d time.Duration) time.Duration {
+	return t.Delay + d
+}
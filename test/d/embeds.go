@@ -0,0 +1,22 @@
+package d
+
+type base struct {
+	ID     int
+	hidden string
+}
+
+// Touch method promoted from the unexported embedded struct
+func (b *base) Touch() {}
+
+type timestamps struct {
+	Created string
+	Updated string
+}
+
+// User with unexported embedded structs
+type User struct {
+	base
+	*timestamps
+
+	Name string
+}
@@ -0,0 +1,15 @@
+package d
+
+// Empty struct
+type Empty struct{}
+
+// WithEmpty with empty struct and zero length array fields
+type WithEmpty struct {
+	Marker struct{}
+	Nested struct {
+		Inner struct{}
+	}
+	Empty Empty
+	Zero  [0]int
+	Set   map[string]struct{}
+}
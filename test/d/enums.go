@@ -0,0 +1,29 @@
+package d
+
+// Status is an integer enum
+type Status int
+
+// status constants
+const (
+	// StatusActive is the first status
+	StatusActive   Status = iota + 1
+	StatusInactive        // trailing status doc
+	StatusBanned
+)
+
+// Quote is a string enum with escaped values
+type Quote string
+
+// quote constants
+const (
+	QuoteSingle  Quote = "it's"
+	QuoteNewline Quote = "line\nbreak"
+	QuoteControl Quote = "\a\x00\\"
+	QuoteEmoji   Quote = "\U0001F600"
+)
+
+// Size is a 64-bit integer enum candidate
+type Size int64
+
+// SizeMax exceeds the JS safe integer
+const SizeMax Size = 1<<63 - 1
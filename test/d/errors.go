@@ -0,0 +1,12 @@
+package d
+
+// Failable with error fields, params and results
+type Failable struct {
+	Err    error
+	Errors []error
+}
+
+// Wrap method with error params and results
+func (f *Failable) Wrap(err error, others ...error) (int, error) {
+	return 0, err
+}
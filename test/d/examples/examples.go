@@ -0,0 +1,21 @@
+// Package examples is used to check the generation of the Go testable examples.
+package examples
+
+// Greeter greets people.
+type Greeter struct {
+	Prefix string
+}
+
+// Greet returns the greeting for the specified name.
+func (g *Greeter) Greet(name string) string {
+	return g.Prefix + " " + name
+}
+
+// Sum returns the sum of the specified numbers.
+func Sum(nums ...int) int {
+	var total int
+	for _, n := range nums {
+		total += n
+	}
+	return total
+}
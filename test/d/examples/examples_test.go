@@ -0,0 +1,37 @@
+package examples_test
+
+import (
+	"fmt"
+
+	"github.com/hanzoai/tygojaPB/test/d/examples"
+)
+
+func ExampleGreeter() {
+	g := &examples.Greeter{Prefix: "Hello"}
+
+	fmt.Println(g.Prefix)
+	// Output: Hello
+}
+
+func ExampleGreeter_Greet() {
+	g := &examples.Greeter{Prefix: "Hi"}
+
+	// greet a single person
+	fmt.Println(g.Greet("John"))
+	// Output: Hi John
+}
+
+func ExampleSum() {
+	fmt.Println(examples.Sum(1, 2, 3))
+	// Output: 6
+}
+
+// Sum of the individual numbers.
+func ExampleSum_single() {
+	for _, n := range []int{3, 1} {
+		fmt.Println(examples.Sum(n))
+	}
+	// Unordered output:
+	// 1
+	// 3
+}
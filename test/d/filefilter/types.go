@@ -0,0 +1,8 @@
+package filefilter
+
+// note: separate package to check the Config.FileFilter with all package types
+
+// Kept is declared in a regular file
+type Kept struct {
+	Value string
+}
@@ -0,0 +1,9 @@
+package filefilter
+
+// Generated is declared in a generated file that is excluded
+type Generated struct {
+	Value string
+}
+
+// Method of a kept type declared in an excluded file
+func (k Kept) Method() {}
@@ -0,0 +1,36 @@
+package d
+
+// Stable is always generated
+type Stable struct {
+	Value string
+}
+
+// Debug method that is filtered by name
+func (s Stable) Debug() {}
+
+// Run method that is generated
+func (s Stable) Run() {}
+
+// Unstable is an experimental type that is filtered by its doc
+type Unstable struct {
+	Value string
+}
+
+// NewUnstable is an experimental function that is filtered by its doc
+func NewUnstable() *Unstable {
+	return nil
+}
+
+// NewStable is generated
+func NewStable() *Stable {
+	return nil
+}
+
+// MultiX and MultiY are declared with a single spec (only MultiX is filtered)
+const MultiX, MultiY = 1, 2
+
+const (
+	// MultiA and MultiB are grouped (only MultiA is filtered)
+	MultiA, MultiB = "a", "b"
+	MultiC         = "c"
+)
@@ -0,0 +1,14 @@
+package d
+
+// Permission is a bit flag
+type Permission int
+
+// bit flag constants
+const (
+	PermissionRead Permission = 1 << iota
+	PermissionWrite
+	PermissionExec
+)
+
+// constant expression with mixed operands
+const PermissionAll = PermissionRead | PermissionWrite | PermissionExec
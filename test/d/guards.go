@@ -0,0 +1,12 @@
+package d
+
+// Measurements with all basic kinds
+type Measurements struct {
+	Count  int64
+	Ratio  float64
+	Signal complex128
+	Label  string
+	Ok     bool
+	Sizes  []uint64
+	Levels map[string]int
+}
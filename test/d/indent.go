@@ -0,0 +1,28 @@
+package d
+
+// Hooks with nested anonymous types
+type Hooks struct {
+	OnResult func() struct {
+		Code int
+	}
+	OnPair func() (struct{ A int }, struct{ B string })
+	Source interface {
+		Get() interface {
+			Value() int
+		}
+	}
+}
+
+// Factory returns anonymous types
+func (h *Hooks) Factory() func() struct {
+	Name string
+} {
+	return nil
+}
+
+// NewHooks is a package function with anonymous result
+func NewHooks() interface {
+	Close() error
+} {
+	return nil
+}
@@ -0,0 +1,19 @@
+package d
+
+import "github.com/hanzoai/tygojaPB/test/d/keys"
+
+// Slot is a named numeric key type
+type Slot uint8
+
+// Coord is a non-indexable key type
+type Coord struct {
+	X, Y int
+}
+
+// Indexes with named map key types
+type Indexes struct {
+	ByEmail map[Email]string
+	BySlot  map[Slot]bool
+	ByCode  map[keys.Code]int
+	ByCoord map[Coord]string
+}
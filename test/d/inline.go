@@ -0,0 +1,16 @@
+package d
+
+// Deep with 5 levels of nested anonymous structs
+type Deep struct {
+	Level1 struct {
+		Level2 struct {
+			Level3 struct {
+				Level4 struct {
+					Level5 struct {
+						Value string
+					}
+				}
+			}
+		}
+	}
+}
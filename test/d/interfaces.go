@@ -0,0 +1,20 @@
+package d
+
+// Reader named interface
+type Reader interface {
+	Read(p []byte) (int, error)
+}
+
+// Streams with named interface and identical interface literals
+type Streams struct {
+	Named   Reader
+	Literal interface {
+		Read(p []byte) (int, error)
+	}
+	Literals []interface {
+		Read(p []byte) (int, error)
+	}
+	Other interface {
+		Close() error
+	}
+}
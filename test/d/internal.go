@@ -0,0 +1,35 @@
+package d
+
+// Registry is an internal helper.
+//
+//tygoja:internal
+type Registry struct {
+	Items []string
+}
+
+// Catalog with internal members
+type Catalog struct {
+	Name string
+
+	// Cache is an internal field.
+	//
+	//tygoja:internal
+	Cache map[string]any
+}
+
+// Reset is an internal method.
+//
+//tygoja:internal
+func (s *Catalog) Reset() {}
+
+// Get is public.
+func (s *Catalog) Get(key string) any {
+	return nil
+}
+
+// NewRegistry is an internal function.
+//
+//tygoja:internal
+func NewRegistry() *Registry {
+	return nil
+}
@@ -0,0 +1,18 @@
+//go:build go1.23
+
+package d
+
+import "iter"
+
+// Stream with iterator and channel fields
+type Stream struct {
+	Values  iter.Seq[int]
+	Entries iter.Seq2[string, *Event]
+	Inline  func(yield func(Event) bool)
+	Events  chan Event
+}
+
+// All returns an iterator over all stream events
+func (s *Stream) All() iter.Seq[Event] {
+	return nil
+}
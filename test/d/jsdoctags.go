@@ -0,0 +1,11 @@
+package d
+
+// Setting with JSDoc struct tags
+type Setting struct {
+	// Key of the setting
+	Key string `doc:"readonly,since=1.2"`
+
+	Value   any    `example:"{\"enabled\": true}"`
+	Comment string `doc:"deprecated=use Value instead"`
+	Plain   string
+}
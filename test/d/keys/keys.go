@@ -0,0 +1,4 @@
+package keys
+
+// Code is a named string key type
+type Code string
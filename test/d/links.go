@@ -0,0 +1,26 @@
+package d
+
+import "github.com/hanzoai/tygojaPB/test/d/keys"
+
+// Article is written by an Author and has a unique Code
+// (unlike the [keys.Code] of the other package).
+//
+// The `Author` code spans and the existing {@link Author} links are preserved.
+type Article struct {
+	// Author of the article (see Author.Name).
+	Author *Author
+
+	// Code of the article.
+	Code Code
+
+	// Key referencing keys.Code.
+	Key keys.Code
+}
+
+// Author of an Article
+type Author struct {
+	Name string
+}
+
+// Code is a unique Article identifier
+type Code string
@@ -0,0 +1,24 @@
+package d
+
+// note: the package is used for the Config options specific fixtures (see test/options.go)
+
+type CustomType struct {
+	Value string
+}
+
+// struct with mapped types nested in slices, maps and pointers
+type MappedElements struct {
+	Single   CustomType
+	Pointer  *CustomType
+	Slice    []CustomType
+	Pointers []*CustomType
+	Array    [2]CustomType
+	Map      map[string]CustomType
+	Nested   [][]CustomType
+	Bytes    []byte
+}
+
+// Variadic with mapped variadic elements
+func (m MappedElements) Variadic(items ...CustomType) []CustomType {
+	return items
+}
@@ -0,0 +1,9 @@
+package d
+
+// Dicts with any valued maps
+type Dicts struct {
+	Interface map[string]interface{}
+	Any       map[string]any
+	Nested    map[string]map[string]any
+	ByID      map[int]any
+}
@@ -0,0 +1,33 @@
+package d
+
+// Experiment is excluded.
+//
+// [EXPERIMENTAL]
+type Experiment struct{}
+
+// Feature with excluded members
+type Feature struct {
+	Name string
+
+	// Beta is an excluded field [EXPERIMENTAL]
+	Beta bool
+
+	Legacy string // kept since the trailing comment is not a doc comment [EXPERIMENTAL]
+}
+
+// Toggle is an excluded method
+//
+// [EXPERIMENTAL]
+func (f *Feature) Toggle() {}
+
+// Enable is kept
+func (f *Feature) Enable() {}
+
+// Switcher with excluded interface method
+type Switcher interface {
+	// Switch is excluded [EXPERIMENTAL]
+	Switch()
+
+	// Reset is kept
+	Reset()
+}
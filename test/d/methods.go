@@ -0,0 +1,24 @@
+package d
+
+// ReservedMethods with methods renamed by the
+// MethodNameFormatter to reserved or invalid JS names
+type ReservedMethods interface {
+	Delete(key string) error
+
+	Default() string
+
+	ContentType() string
+}
+
+// Resource with struct methods renamed to invalid JS names
+type Resource struct{}
+
+// ContentType returns the resource content type
+func (r *Resource) ContentType() string {
+	return ""
+}
+
+// New is a renamed reserved word method
+func (r *Resource) New() *Resource {
+	return nil
+}
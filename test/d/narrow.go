@@ -0,0 +1,39 @@
+package d
+
+import "errors"
+
+// Store interface
+type Store interface {
+	Get(key string) string
+}
+
+// MemoryStore implements Store
+type MemoryStore struct{}
+
+func (s *MemoryStore) Get(key string) string { return "" }
+
+// FileStore implements Store
+type FileStore struct{}
+
+func (s FileStore) Get(key string) string { return "" }
+
+// NewMemoryStore is narrowed to MemoryStore
+func NewMemoryStore() (Store, error) {
+	return &MemoryStore{}, nil
+}
+
+// NewStore is not narrowed because of the different returned types
+func NewStore(inMemory bool) Store {
+	if inMemory {
+		return &MemoryStore{}
+	}
+	return FileStore{}
+}
+
+// NewCheckedStore is not narrowed because of the non composite literal return
+func NewCheckedStore(ok bool) (Store, error) {
+	if !ok {
+		return nil, errors.New("invalid store")
+	}
+	return &MemoryStore{}, nil
+}
@@ -0,0 +1,22 @@
+package d
+
+// Weight is a named numeric type with methods
+type Weight float64
+
+// Kilograms returns the weight in kilograms
+func (w Weight) Kilograms() float64 {
+	return float64(w) / 1000
+}
+
+// Reading with numeric fields
+type Reading struct {
+	Count   int
+	Ratio   float32
+	Size    uint64
+	Weights []Weight
+}
+
+// Scale returns the scaled ratio
+func (r *Reading) Scale(factor float64, times ...int) float64 {
+	return float64(r.Ratio) * factor
+}
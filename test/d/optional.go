@@ -0,0 +1,30 @@
+package d
+
+// Connect with optional trailing params
+//
+//tygoja:optional-from=1
+func Connect(dsn string, timeout, retries int) error {
+	return nil
+}
+
+// Log with optional params before the variadic one
+//
+//tygoja:optional-from=1
+func Log(format string, level int, args ...any) {}
+
+// Open with invalid directive value
+//
+//tygoja:optional-from=-1
+func Open(path string, flag int) error {
+	return nil
+}
+
+// Client with optional method params
+type Client struct{}
+
+// Get with all params optional
+//
+//tygoja:optional-from=0
+func (c *Client) Get(key string, fallback string) string {
+	return ""
+}
@@ -0,0 +1,6 @@
+package client
+
+// New creates a new gRPC client
+func New(host string, port int) string {
+	return host
+}
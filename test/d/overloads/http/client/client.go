@@ -0,0 +1,6 @@
+package client
+
+// New creates a new HTTP client
+func New(baseURL string) string {
+	return baseURL
+}
@@ -0,0 +1,7 @@
+package d
+
+// UpdatePayload with partial variant
+type UpdatePayload struct {
+	Name  *string `json:"name,omitempty"`
+	Count *int    `json:"count,omitempty"`
+}
@@ -0,0 +1,10 @@
+package d
+
+import "encoding/json"
+
+// Payload with raw JSON fields
+type Payload struct {
+	Data     json.RawMessage
+	Optional *json.RawMessage
+	Bytes    []byte
+}
@@ -0,0 +1,11 @@
+package d
+
+// Keywords with reserved word field names
+type Keywords struct {
+	Class   string `json:"class"`
+	Default bool   `json:"default"`
+	New     int    `json:"new"`
+	Await   string `json:"await"`
+	Kind    string `json:"kind"`
+	Dashed  string `json:"dashed-name"`
+}
@@ -0,0 +1,27 @@
+package d
+
+// Lookup with named results methods
+type Lookup struct{}
+
+// Find searches for the specified key.
+func (l *Lookup) Find(key string) (
+	value string, // the found value
+	found bool, // whether the key exists
+) {
+	return "", false
+}
+
+// Bounds returns the lookup bounds.
+func (l *Lookup) Bounds() (min, max int, err error) {
+	return 0, 0, nil
+}
+
+// Split splits the path without named results.
+func Split(path string) (string, string) {
+	return "", ""
+}
+
+// Parse parses the raw value.
+func Parse(raw string) (value int, rest string) {
+	return 0, ""
+}
@@ -0,0 +1,28 @@
+package shadowing
+
+// note: separate package because its declarations shadow the JS globals
+
+// Number constraint that shadows the JS global Number
+type Number interface {
+	~int | ~float64
+}
+
+// Amount with Number base type
+type Amount int
+
+// Sum with Number constraint type param
+func Sum[T Number](values ...T) T {
+	var result T
+	return result
+}
+
+// Error that shadows the JS global Error
+type Error struct {
+	Code int
+}
+
+// Outcome with builtin error field
+type Outcome struct {
+	Err    error
+	Custom Error
+}
@@ -0,0 +1,25 @@
+package d
+
+// Result is implemented by a closed set of structs
+type Result interface {
+	IsResult() bool
+}
+
+// Success implements Result with value receiver
+type Success struct {
+	Data string
+}
+
+func (s Success) IsResult() bool { return true }
+
+// Failure implements Result with pointer receiver
+type Failure struct {
+	Message string
+}
+
+func (f *Failure) IsResult() bool { return false }
+
+// Other doesn't implement Result
+type Other struct {
+	Data string
+}
@@ -0,0 +1,12 @@
+package d
+
+import "sync"
+
+// Cache with opaque sync types
+type Cache struct {
+	sync.RWMutex
+
+	Items sync.Map
+	Mu    sync.Mutex
+	Once  *sync.Once
+}
@@ -0,0 +1,12 @@
+package d
+
+// Tagged with js struct tags
+type Tagged struct {
+	Renamed  string `js:"customName" json:"jsonName"`
+	Options  string `js:"withOptions,omitempty"`
+	Skipped  string `js:"-"`
+	NoName   string `js:",omitempty"`
+	Untagged string
+	JSONOnly string `json:"jsonOnly"`
+	Pattern  string `glob:"*/*.go"`
+}
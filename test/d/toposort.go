@@ -0,0 +1,42 @@
+package d
+
+// Order references types declared after it
+type Order struct {
+	Customer *Customer
+	Items    []Item
+}
+
+// Item of an order
+type Item struct {
+	Name string
+}
+
+// Customer references a type only with its method
+type Customer struct {
+	Address Address
+}
+
+// LastInvoice returns the customer last invoice
+func (c *Customer) LastInvoice() *Invoice {
+	return nil
+}
+
+// Address of a customer
+type Address struct {
+	City string
+}
+
+// Invoice is referenced only by a method
+type Invoice struct {
+	Total float64
+}
+
+// Parent and Child are cyclic
+type Parent struct {
+	Children []*Child
+}
+
+// Child of a parent
+type Child struct {
+	Parent *Parent
+}
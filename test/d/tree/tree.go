@@ -0,0 +1,9 @@
+package tree
+
+import "github.com/hanzoai/tygojaPB/test/d/keys"
+
+// Node references a type from another package
+type Node struct {
+	Code     keys.Code
+	Children []*Node
+}
@@ -0,0 +1,11 @@
+package d
+
+import "unsafe"
+
+// Handle with unsafe pointer fields
+type Handle struct {
+	Name string
+	Ptr  unsafe.Pointer
+	Ptrs []unsafe.Pointer
+	Addr uintptr
+}
@@ -0,0 +1,20 @@
+package d
+
+// Worker with func fields without results
+type Worker struct {
+	OnStart func()
+	OnStop  func() error
+}
+
+// Run method without results
+func (w *Worker) Run() {}
+
+// Close method with only an error result
+func (w *Worker) Close() error {
+	return nil
+}
+
+// Shutdown function with only an error result
+func Shutdown(force bool) error {
+	return nil
+}
@@ -0,0 +1,10 @@
+package d
+
+// Imaginary is a complex constant
+const Imaginary = -2i
+
+// complex constants group
+const (
+	Unit     complex64 = 1i
+	Rotation           = Unit * Unit
+)
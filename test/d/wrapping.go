@@ -0,0 +1,19 @@
+package d
+
+// Entity is a long type set union
+type Entity interface {
+	Account | Measurements | Indexes | Keywords | OldClient | Feature | Tagged
+}
+
+// Mailer with long method params
+type Mailer struct{}
+
+// Send has params exceeding the max line length
+func (m *Mailer) Send(from string, to []string, subject string, body string, attachments map[string][]byte, priority int) error {
+	return nil
+}
+
+// Ping has short params
+func (m *Mailer) Ping(host string) bool {
+	return true
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hanzoai/tygojaPB"
+)
+
+// checkFilesFixture writes the GenerateFiles result of a module mode config
+// with cross package references in a temporary directory and checks
+// the per-package files and the GenerateBarrel re-exports.
+func checkFilesFixture() error {
+	config := tygojaPB.Config{
+		Packages:        map[string][]string{fixturesPkg + "/tree": {"*"}},
+		DeclarationMode: tygojaPB.DeclarationModeModule,
+	}
+
+	files, err := tygojaPB.New(config).GenerateFiles()
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "tygoja_files")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+
+	expectedNames := []string{"_tygoja.d.ts", "keys.d.ts", "tree.d.ts"}
+	if !slices.Equal(names, expectedNames) {
+		return fmt.Errorf("expected files %v, got %v", expectedNames, names)
+	}
+
+	tree, err := os.ReadFile(filepath.Join(dir, "tree.d.ts"))
+	if err != nil {
+		return err
+	}
+
+	for _, part := range []string{
+		`import type { _TygojaDict, _TygojaAny } from "./_tygoja"`,
+		`import type { keys } from "./keys"`,
+		"export namespace tree {",
+		"Code: keys.Code",
+	} {
+		if !strings.Contains(string(tree), part) {
+			return fmt.Errorf("expected tree.d.ts to contain %q, got:\n%s", part, tree)
+		}
+	}
+
+	expectedBarrel := "// GENERATED CODE - DO NOT MODIFY BY HAND\n" +
+		"export * from \"./_tygoja\";\n" +
+		"export * from \"./keys\";\n" +
+		"export * from \"./tree\";\n"
+
+	// the barrel must be the same on every run
+	for range 3 {
+		barrel, err := tygojaPB.New(config).GenerateBarrel()
+		if err != nil {
+			return err
+		}
+
+		if barrel != expectedBarrel {
+			return fmt.Errorf("expected barrel:\n%s\ngot:\n%s", expectedBarrel, barrel)
+		}
+	}
+
+	return nil
+}
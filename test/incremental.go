@@ -0,0 +1,132 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hanzoai/tygojaPB"
+)
+
+// incrementalDir is the temporary package directory used
+// to check the GenerateIncremental changes detection.
+const incrementalDir = "./incremental"
+
+const incrementalSource = `package incremental
+
+// Level type
+type Level int
+
+// level constants
+const (
+	LevelDebug Level = iota
+	LevelInfo // info level
+)
+
+// DefaultLevel references another constant
+const DefaultLevel = LevelInfo
+
+// Logger is unchanged between the runs
+type Logger struct {
+	Level Level
+}
+`
+
+// generateIncrementalFixture runs GenerateIncremental before and after
+// editing the temporary package source and writes the results of each
+// case in ./options/incremental_{name}{before,after}.d.ts.
+//
+// It also checks that the second run matches a full Generate of the edited package.
+func generateIncrementalFixture() error {
+	cases := []struct {
+		name   string
+		config tygojaPB.Config
+		edit   func(src string) string
+	}{
+		{
+			// change the values of all constants without changing the DefaultLevel declaration source
+			name: "",
+			config: tygojaPB.Config{
+				Packages:      map[string][]string{"github.com/hanzoai/tygojaPB/test/incremental": {"*"}},
+				WithConstants: true,
+			},
+			edit: func(src string) string {
+				return strings.Replace(src, "= iota", "= iota + 10", 1)
+			},
+		},
+		{
+			// change only the doc of an enum member without changing the enum type declaration source
+			name: "enum_",
+			config: tygojaPB.Config{
+				Packages:      map[string][]string{"github.com/hanzoai/tygojaPB/test/incremental": {"*"}},
+				WithConstants: true,
+				EnumStyle:     tygojaPB.EnumStyleEnum,
+			},
+			edit: func(src string) string {
+				return strings.Replace(src, "// info level", "// info level (default)", 1)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		before, after, err := generateIncrementalCase(c.config, c.edit)
+		if err != nil {
+			return err
+		}
+
+		if err := writeOptionFixture("incremental_"+c.name+"before.d.ts", before); err != nil {
+			return err
+		}
+
+		if err := writeOptionFixture("incremental_"+c.name+"after.d.ts", after); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateIncrementalCase generates the temporary package
+// incrementally before and after applying the edit func.
+func generateIncrementalCase(config tygojaPB.Config, edit func(src string) string) (string, string, error) {
+	if err := os.RemoveAll(incrementalDir); err != nil {
+		return "", "", err
+	}
+	defer os.RemoveAll(incrementalDir)
+
+	if err := os.Mkdir(incrementalDir, 0755); err != nil {
+		return "", "", err
+	}
+
+	sourceFile := filepath.Join(incrementalDir, "incremental.go")
+
+	if err := os.WriteFile(sourceFile, []byte(incrementalSource), 0644); err != nil {
+		return "", "", err
+	}
+
+	before, manifest, err := tygojaPB.New(config).GenerateIncremental(tygojaPB.Manifest{})
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := os.WriteFile(sourceFile, []byte(edit(incrementalSource)), 0644); err != nil {
+		return "", "", err
+	}
+
+	after, _, err := tygojaPB.New(config).GenerateIncremental(manifest)
+	if err != nil {
+		return "", "", err
+	}
+
+	full, err := tygojaPB.New(config).Generate()
+	if err != nil {
+		return "", "", err
+	}
+
+	if after == before || after != full {
+		return "", "", errors.New("the incremental generation doesn't match the full generation after the source change")
+	}
+
+	return before, after, nil
+}
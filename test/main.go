@@ -1,8 +1,10 @@
 package main
 
 import (
+	"encoding/json"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/hanzoai/tygojaPB"
 )
@@ -14,8 +16,14 @@ func main() {
 			"github.com/hanzoai/tygojaPB/test/b": {"*"},
 			"github.com/hanzoai/tygojaPB/test/c": {"Example2", "Handler"},
 		},
-		Heading:              `declare var $app: c.Handler;`,
+		Heading: `declare var $app: c.Handler;`,
+		Globals: map[string]string{
+			// time.Ticker is not referenced by the packages
+			// so its declaration comes only from the global
+			"$ticker": "*time.Ticker",
+		},
 		WithPackageFunctions: true,
+		SeeAlsoAsJSDoc:       true,
 		// enable if you want to be able to import them
 		// StartModifier: "export",
 	})
@@ -29,5 +37,74 @@ func main() {
 		log.Fatal(err)
 	}
 
+	graph, err := gen.DeclarationGraph()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// skip the std lib declarations to keep the output small
+	// (the references to them are still included)
+	ownNodes := graph.Nodes[:0]
+	for _, n := range graph.Nodes {
+		if strings.HasPrefix(n.Package, "github.com/hanzoai/tygojaPB/test/") {
+			ownNodes = append(ownNodes, n)
+		}
+	}
+	graph.Nodes = ownNodes
+
+	ownEdges := graph.Edges[:0]
+	for _, e := range graph.Edges {
+		if strings.HasPrefix(e.From, "github.com/hanzoai/tygojaPB/test/") {
+			ownEdges = append(ownEdges, e)
+		}
+	}
+	graph.Edges = ownEdges
+
+	graphJSON, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("./graph.json", graphJSON, 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	guards, err := gen.GenerateTypeGuards()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("./typeguards.ts", []byte(guards), 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := generateOptionFixtures(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := generateTypeGuardFixtures(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := generateIncrementalFixture(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := generateTreeFixture(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := generateWatchFixture(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := checkFilesFixture(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := checkValidateFixtures(); err != nil {
+		log.Fatal(err)
+	}
+
 	// run `npx typedoc` to generate HTML docs from the above declarations
 }
@@ -0,0 +1,727 @@
+package main
+
+import (
+	"go/ast"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hanzoai/tygojaPB"
+)
+
+const fixturesPkg = "github.com/hanzoai/tygojaPB/test/d"
+
+// optionFixture describes a single Config option fixture.
+//
+// Each fixture is generated with a separate generator run in
+// ./options/{name}.d.ts to avoid changing the shared types.d.ts output.
+type optionFixture struct {
+	name   string
+	config tygojaPB.Config
+}
+
+var optionFixtures = []optionFixture{
+	{
+		name: "type_mappings",
+		config: tygojaPB.Config{
+			Packages:       map[string][]string{fixturesPkg: {"MappedElements"}},
+			ExpandMapTypes: true, // to check the map value type
+			TypeMappings: map[string]string{
+				"CustomType": "MyTSType",
+				"byte":       "MyByte",
+			},
+		},
+	},
+	{
+		name: "collapse_single_field_structs",
+		config: tygojaPB.Config{
+			Packages:                   map[string][]string{fixturesPkg: {"Wrapper", "GenericWrapper", "Pair", "WrapperWithMethods"}},
+			CollapseSingleFieldStructs: true,
+		},
+	},
+	{
+		name: "sum_type_interfaces",
+		config: tygojaPB.Config{
+			Packages:             map[string][]string{fixturesPkg: {"Result", "Success", "Failure", "Other"}},
+			SumTypeInterfaces:    []string{fixturesPkg + ".Result"},
+			SumTypeDiscriminator: "kind",
+		},
+	},
+	{
+		name: "bit_flag_constants",
+		config: tygojaPB.Config{
+			Packages:      map[string][]string{fixturesPkg: {"Permission", "PermissionRead", "PermissionWrite", "PermissionExec", "PermissionAll"}},
+			WithConstants: true,
+		},
+	},
+	{
+		name: "const_docs",
+		config: tygojaPB.Config{
+			Packages:      map[string][]string{fixturesPkg: {"Color", "Red", "Green", "Blue", "DefaultColor"}},
+			WithConstants: true,
+		},
+	},
+	{
+		name: "unsafe_pointer",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"Handle"}},
+		},
+	},
+	{
+		name: "drop_unsafe_fields",
+		config: tygojaPB.Config{
+			Packages:         map[string][]string{fixturesPkg: {"Handle"}},
+			DropUnsafeFields: true,
+		},
+	},
+	{
+		name: "pointer_map_values",
+		config: tygojaPB.Config{
+			Packages:       map[string][]string{"github.com/hanzoai/tygojaPB/test/b": {"Registry"}},
+			ExpandMapTypes: true, // to check the map value type
+		},
+	},
+	{
+		name: "compact_empty_types",
+		config: tygojaPB.Config{
+			Packages:          map[string][]string{fixturesPkg: {"Empty", "WithEmpty"}},
+			ExpandMapTypes:    true, // to check the map value type
+			CompactEmptyTypes: true,
+		},
+	},
+	{
+		name: "int64_as_bigint",
+		config: tygojaPB.Config{
+			Packages:       map[string][]string{fixturesPkg: {"Counter"}},
+			ExpandMapTypes: true, // to check the map key fallback
+			Int64AsBigInt:  true,
+		},
+	},
+	{
+		name: "field_tag_key",
+		config: tygojaPB.Config{
+			Packages:    map[string][]string{fixturesPkg: {"Tagged"}},
+			FieldTagKey: "js",
+			FieldNameFormatter: func(name string) string {
+				// applied after the tag name extraction
+				return "_" + name
+			},
+		},
+	},
+	{
+		name: "sort_fields",
+		config: tygojaPB.Config{
+			Packages:    map[string][]string{fixturesPkg: {"Tagged"}},
+			FieldTagKey: "js", // to check that the final names are sorted
+			SortFields:  true,
+		},
+	},
+	{
+		name: "max_inline_depth",
+		config: tygojaPB.Config{
+			Packages:       map[string][]string{fixturesPkg: {"Deep"}},
+			MaxInlineDepth: 2,
+		},
+	},
+	{
+		name: "namespace_per_package",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{
+				"github.com/hanzoai/tygojaPB/test/b": {"Container"}, // with cross-package references
+				fixturesPkg:                          {"Wrapper"},
+			},
+			NamespacePerPackage: true,
+		},
+	},
+	{
+		name: "error_type",
+		config: tygojaPB.Config{
+			Packages:  map[string][]string{fixturesPkg: {"Failable"}},
+			Heading:   "type GoError = Error & { value: any }\n",
+			ErrorType: "GoError",
+		},
+	},
+	{
+		name: "json_raw_message",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"Payload"}},
+		},
+	},
+	{
+		name: "declaration_filter",
+		config: tygojaPB.Config{
+			Packages:             map[string][]string{fixturesPkg: {"Stable", "Unstable", "NewUnstable", "NewStable"}},
+			WithPackageFunctions: true,
+			DeclarationFilter: func(d tygojaPB.Declaration) bool {
+				if d.Kind == tygojaPB.DeclarationKindMethod && d.Name == "Stable.Debug" {
+					return false
+				}
+				return !strings.Contains(d.Doc, "experimental")
+			},
+		},
+	},
+	{
+		name: "variadic_byte",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"Buffer"}},
+		},
+	},
+	{
+		name: "variadic_byte_as_array",
+		config: tygojaPB.Config{
+			Packages:            map[string][]string{fixturesPkg: {"Buffer"}},
+			VariadicByteAsArray: true,
+		},
+	},
+	{
+		name: "generate_partial_variants",
+		config: tygojaPB.Config{
+			Packages:                map[string][]string{fixturesPkg: {"UpdatePayload", "Pair"}},
+			GeneratePartialVariants: []string{fixturesPkg + ".UpdatePayload"},
+		},
+	},
+	{
+		name: "global_types_shadowing",
+		config: tygojaPB.Config{
+			Packages:             map[string][]string{fixturesPkg + "/shadowing": {"Number", "Amount", "Sum", "Error", "Outcome"}},
+			WithPackageFunctions: true,
+		},
+	},
+	{
+		name: "struct_as_type",
+		config: tygojaPB.Config{
+			Packages:      map[string][]string{fixturesPkg: {"Pair", "WrapperWithMethods", "Empty"}},
+			StartModifier: "export",
+			StructAsType:  true,
+		},
+	},
+	{
+		name: "dot_import",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg + "/dotimport": {"Local", "WithDotImport"}},
+		},
+	},
+	{
+		name: "narrow_interface_returns",
+		config: tygojaPB.Config{
+			Packages:               map[string][]string{fixturesPkg: {"Store", "MemoryStore", "FileStore", "NewMemoryStore", "NewStore", "NewCheckedStore"}},
+			WithPackageFunctions:   true,
+			NarrowInterfaceReturns: true,
+		},
+	},
+	{
+		name: "type_name_formatter",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{
+				"github.com/hanzoai/tygojaPB/test/b": {"Container"}, // with cross-package references
+				fixturesPkg:                          {"Store", "MemoryStore", "NewMemoryStore"},
+			},
+			WithPackageFunctions: true,
+			TypeNameFormatter: func(kind string, name string) string {
+				if kind == tygojaPB.DeclarationKindFunc {
+					return strings.ToLower(name[:1]) + name[1:]
+				}
+				return "Go" + name
+			},
+		},
+	},
+	{
+		name: "explicit_this_param",
+		config: tygojaPB.Config{
+			Packages:          map[string][]string{fixturesPkg: {"Emitter", "Listener"}},
+			ExplicitThisParam: true,
+		},
+	},
+	{
+		name: "expand_map_types",
+		config: tygojaPB.Config{
+			Packages:       map[string][]string{fixturesPkg: {"Dicts"}},
+			ExpandMapTypes: true,
+		},
+	},
+	{
+		name: "file_filter",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg + "/filefilter": {"*"}},
+			FileFilter: func(path string) bool {
+				return !strings.HasSuffix(path, "_gen.go")
+			},
+		},
+	},
+	{
+		name: "array_syntax_generic",
+		config: tygojaPB.Config{
+			Packages:    map[string][]string{fixturesPkg: {"Arrays"}},
+			ArraySyntax: tygojaPB.ArraySyntaxGeneric,
+		},
+	},
+	{
+		name: "array_syntax_shorthand",
+		config: tygojaPB.Config{
+			Packages:    map[string][]string{fixturesPkg: {"Arrays"}},
+			ArraySyntax: tygojaPB.ArraySyntaxShorthand,
+		},
+	},
+	{
+		name: "opaque_sync_types",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"Cache"}},
+			TypeMappings: map[string]string{
+				"sync.Once": "never", // overwrite a default mapping
+			},
+		},
+	},
+	{
+		name: "type_aliases",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"KeyValue", "PairAlias", "Entries", "Pair"}},
+		},
+	},
+	{
+		name: "inline_unexported_embeds",
+		config: tygojaPB.Config{
+			Packages:               map[string][]string{fixturesPkg: {"User"}},
+			InlineUnexportedEmbeds: true,
+		},
+	},
+	{
+		name: "named_interfaces",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"Reader", "Streams"}},
+		},
+	},
+	{
+		name: "topo_sort",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"Order", "Item", "Customer", "Address", "Invoice", "Parent", "Child"}},
+			TopoSort: true,
+		},
+	},
+	{
+		name: "roots",
+		config: tygojaPB.Config{
+			Roots: []string{fixturesPkg + ".Order"},
+		},
+	},
+	{
+		name: "optional_params",
+		config: tygojaPB.Config{
+			Packages:             map[string][]string{fixturesPkg: {"Connect", "Log", "Open", "Client"}},
+			WithPackageFunctions: true,
+		},
+	},
+	{
+		name: "nested_indentation",
+		config: tygojaPB.Config{
+			Packages:             map[string][]string{fixturesPkg: {"Hooks", "NewHooks"}},
+			WithPackageFunctions: true,
+		},
+	},
+	{
+		name: "map_type_template",
+		config: tygojaPB.Config{
+			Packages:        map[string][]string{fixturesPkg: {"Dicts"}},
+			MapTypeTemplate: "Map<{{.Key}}, {{.Value}}>",
+		},
+	},
+	{
+		name: "channel_type",
+		config: tygojaPB.Config{
+			Packages:                map[string][]string{fixturesPkg: {"Event", "Pipes"}},
+			ChannelType:             "ReadableStream",
+			ChannelsAsAsyncIterable: true, // ignored
+		},
+	},
+	{
+		name: "bool_constants",
+		config: tygojaPB.Config{
+			Packages:      map[string][]string{fixturesPkg: {"Enabled", "Disabled"}},
+			WithConstants: true,
+		},
+	},
+	{
+		name: "warnings",
+		config: tygojaPB.Config{
+			Packages:      map[string][]string{fixturesPkg: {"Imaginary", "Unit", "Rotation"}},
+			WithConstants: true,
+		},
+	},
+	{
+		name: "function_overloads",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{
+				fixturesPkg + "/overloads/http/client": {"New"},
+				fixturesPkg + "/overloads/grpc/client": {"New"},
+			},
+			WithPackageFunctions: true,
+		},
+	},
+	{
+		name: "bool_type",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"Active", "Account"}},
+			BoolType: "0 | 1",
+		},
+	},
+	{
+		name: "string_type",
+		config: tygojaPB.Config{
+			Packages:   map[string][]string{fixturesPkg: {"Email", "Account"}},
+			Heading:    "\ntype GoString = string\n",
+			StringType: "GoString",
+		},
+	},
+	{
+		name: "resolve_named_basic_types",
+		config: tygojaPB.Config{
+			Packages:               map[string][]string{fixturesPkg: {"Account"}},
+			ResolveNamedBasicTypes: true,
+		},
+	},
+	{
+		name: "inline_named_types",
+		config: tygojaPB.Config{
+			Packages:         map[string][]string{fixturesPkg: {"Account"}},
+			InlineNamedTypes: true,
+		},
+	},
+	{
+		name: "enum_style",
+		config: tygojaPB.Config{
+			Packages:      map[string][]string{fixturesPkg: {"Status", "Quote", "Size", "StatusActive", "StatusInactive", "StatusBanned", "QuoteSingle", "QuoteNewline", "QuoteControl", "QuoteEmoji", "SizeMax"}},
+			WithConstants: true,
+			EnumStyle:     tygojaPB.EnumStyleEnum,
+		},
+	},
+	{
+		name: "const_enum_style_bigint",
+		config: tygojaPB.Config{
+			Packages:      map[string][]string{fixturesPkg: {"Status", "Size", "StatusActive", "StatusInactive", "StatusBanned", "SizeMax"}},
+			WithConstants: true,
+			EnumStyle:     tygojaPB.EnumStyleConstEnum,
+			Int64AsBigInt: true, // Size is not an enum
+		},
+	},
+	{
+		name: "emit_struct_tags",
+		config: tygojaPB.Config{
+			Packages:       map[string][]string{fixturesPkg: {"Tagged"}},
+			EmitStructTags: true,
+		},
+	},
+	{
+		name: "default_fallback_type",
+		config: tygojaPB.Config{
+			Packages:            map[string][]string{fixturesPkg: {"Dicts"}},
+			DefaultFallbackType: "unknown",
+			ExpandMapTypes:      true,
+		},
+	},
+	{
+		name: "exclude_markers",
+		config: tygojaPB.Config{
+			Packages:       map[string][]string{fixturesPkg: {"Experiment", "Feature", "Switcher"}},
+			ExcludeMarkers: []string{"[EXPERIMENTAL]"},
+		},
+	},
+	{
+		name: "deprecated_as_jsdoc",
+		config: tygojaPB.Config{
+			Packages:          map[string][]string{fixturesPkg: {"OldClient"}},
+			DeprecatedAsJSDoc: true,
+		},
+	},
+	{
+		name: "type_handler",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"Account", "Dicts"}},
+			TypeHandler: func(t ast.Expr, ctx tygojaPB.HandlerContext) (string, bool) {
+				switch t := t.(type) {
+				case *ast.Ident:
+					if t.Name == "Email" {
+						return "`${string}@${string}`", true
+					}
+				case *ast.MapType:
+					return "Map<" + ctx.WriteType(t.Key) + ", " + ctx.WriteType(t.Value) + ">", true
+				case *ast.ArrayType:
+					// the handled expression itself is written with the builtin handling
+					return "Readonly<" + ctx.WriteType(t) + ">", true
+				}
+				return "", false
+			},
+		},
+	},
+	{
+		name: "named_map_keys",
+		config: tygojaPB.Config{
+			Packages:       map[string][]string{fixturesPkg: {"Indexes"}},
+			ExpandMapTypes: true,
+		},
+	},
+	{
+		name: "package_documentation",
+		config: tygojaPB.Config{
+			Packages:             map[string][]string{fixturesPkg + "/documented": {"*"}},
+			PackageDocumentation: true,
+		},
+	},
+	{
+		name: "reserved_field_names",
+		config: tygojaPB.Config{
+			Packages:    map[string][]string{fixturesPkg: {"Keywords"}},
+			FieldTagKey: "json",
+		},
+	},
+	{
+		name: "module_output",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{
+				"github.com/hanzoai/tygojaPB/test/b": {"Container"}, // with cross-package references
+				fixturesPkg:                          {"Wrapper"},
+			},
+			ModuleOutput: true,
+		},
+	},
+	{
+		name: "max_line_length",
+		config: tygojaPB.Config{
+			Packages:      map[string][]string{fixturesPkg: {"Entity", "Mailer"}},
+			MaxLineLength: 80,
+		},
+	},
+	{
+		name: "const_conversions",
+		config: tygojaPB.Config{
+			Packages:      map[string][]string{fixturesPkg: {"Priority", "LowPriority", "HighPriority", "DefaultCode", "MaxPriority"}},
+			WithConstants: true,
+		},
+	},
+	{
+		name: "method_name_formatter",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"ReservedMethods", "Resource"}},
+			MethodNameFormatter: func(name string) string {
+				if name == "ContentType" {
+					return "content-type"
+				}
+				return strings.ToLower(name)
+			},
+		},
+	},
+	{
+		name: "declaration_filter_multi_name_consts",
+		config: tygojaPB.Config{
+			Packages:      map[string][]string{fixturesPkg: {"MultiX", "MultiY", "MultiA", "MultiB", "MultiC"}},
+			WithConstants: true,
+			DeclarationFilter: func(d tygojaPB.Declaration) bool {
+				return d.Name != "MultiX" && d.Name != "MultiA"
+			},
+		},
+	},
+	{
+		name: "internal_marker",
+		config: tygojaPB.Config{
+			Packages:             map[string][]string{fixturesPkg: {"Registry", "Catalog", "NewRegistry"}},
+			WithPackageFunctions: true,
+		},
+	},
+	{
+		name: "hide_internal",
+		config: tygojaPB.Config{
+			Packages:             map[string][]string{fixturesPkg: {"Registry", "Catalog", "NewRegistry"}},
+			WithPackageFunctions: true,
+			HideInternal:         true,
+		},
+	},
+	{
+		name: "iterators",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"Stream"}},
+		},
+	},
+	{
+		name: "iterators_as_iterable",
+		config: tygojaPB.Config{
+			Packages:                map[string][]string{fixturesPkg: {"Stream"}},
+			IteratorsAsIterable:     true,
+			ChannelsAsAsyncIterable: true,
+		},
+	},
+	{
+		name: "duration_type_number",
+		config: tygojaPB.Config{
+			Packages:     map[string][]string{fixturesPkg: {"Timeout"}},
+			DurationType: tygojaPB.DurationTypeNumber,
+		},
+	},
+	{
+		name: "duration_type_branded",
+		config: tygojaPB.Config{
+			Packages:     map[string][]string{fixturesPkg: {"Timeout"}},
+			DurationType: tygojaPB.DurationTypeBranded,
+		},
+	},
+	{
+		name: "duration_type_mapping_precedence",
+		config: tygojaPB.Config{
+			Packages:     map[string][]string{fixturesPkg: {"Timeout"}},
+			DurationType: tygojaPB.DurationTypeBranded,
+			TypeMappings: map[string]string{"time.Duration": "string"},
+		},
+	},
+	{
+		name: "complex_type",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"Signal"}},
+		},
+	},
+	{
+		name: "complex_type_custom",
+		config: tygojaPB.Config{
+			Packages:    map[string][]string{fixturesPkg: {"Signal"}},
+			ComplexType: "[number, number]",
+		},
+	},
+	{
+		name: "number_type",
+		config: tygojaPB.Config{
+			Packages:   map[string][]string{fixturesPkg: {"Weight", "Reading"}},
+			Heading:    "type GoNumber = number\n",
+			NumberType: "GoNumber",
+		},
+	},
+	{
+		name: "func_return_void_type",
+		config: tygojaPB.Config{
+			Packages:             map[string][]string{fixturesPkg: {"Worker", "Shutdown"}},
+			WithPackageFunctions: true,
+			FuncReturnVoidType:   "undefined",
+		},
+	},
+	{
+		name: "distinguish_int_float",
+		config: tygojaPB.Config{
+			Packages:            map[string][]string{fixturesPkg: {"Weight", "Reading", "Signal"}},
+			DistinguishIntFloat: true,
+		},
+	},
+	{
+		name: "distinguish_int_float_bigint",
+		config: tygojaPB.Config{
+			Packages:            map[string][]string{fixturesPkg: {"Weight", "Reading", "Signal"}},
+			DistinguishIntFloat: true,
+			Int64AsBigInt:       true,
+			ComplexType:         "[number, number]",
+		},
+	},
+	{
+		name: "tag_to_jsdoc",
+		config: tygojaPB.Config{
+			Packages:   map[string][]string{fixturesPkg: {"Setting"}},
+			TagToJSDoc: map[string]string{"doc": "", "example": "example"},
+		},
+	},
+	{
+		name: "named_results_as_jsdoc",
+		config: tygojaPB.Config{
+			Packages:             map[string][]string{fixturesPkg: {"Lookup", "Split", "Parse"}},
+			WithPackageFunctions: true,
+			NamedResultsAsJSDoc:  true,
+		},
+	},
+	{
+		name: "module_guard",
+		config: tygojaPB.Config{
+			Packages:    map[string][]string{fixturesPkg: {"Event"}},
+			Heading:     "declare global { var $event: d.Event }\n",
+			ModuleGuard: true,
+		},
+	},
+	{
+		name: "linkify_docs",
+		config: tygojaPB.Config{
+			Packages:    map[string][]string{fixturesPkg: {"Article", "Author", "Code"}},
+			LinkifyDocs: true,
+		},
+	},
+	{
+		name: "doc_examples",
+		config: tygojaPB.Config{
+			Packages:             map[string][]string{fixturesPkg + "/examples": {"*"}},
+			WithPackageFunctions: true,
+			DocExamples:          true,
+		},
+	},
+	{
+		name: "declaration_mode_namespace",
+		config: tygojaPB.Config{
+			Packages:        map[string][]string{fixturesPkg + "/tree": {"*"}},
+			DeclarationMode: tygojaPB.DeclarationModeNamespace,
+		},
+	},
+	{
+		name: "declaration_mode_ambient_global",
+		config: tygojaPB.Config{
+			Packages:        map[string][]string{fixturesPkg + "/tree": {"*"}},
+			DeclarationMode: tygojaPB.DeclarationModeAmbientGlobal,
+		},
+	},
+	{
+		name: "declaration_mode_module",
+		config: tygojaPB.Config{
+			Packages:        map[string][]string{fixturesPkg + "/tree": {"*"}},
+			DeclarationMode: tygojaPB.DeclarationModeModule,
+		},
+	},
+	{
+		name: "declaration_mode_module_name",
+		config: tygojaPB.Config{
+			Packages:        map[string][]string{fixturesPkg + "/tree": {"*"}},
+			DeclarationMode: tygojaPB.DeclarationModeModule,
+			ModuleName:      "tygoja-fixtures",
+		},
+	},
+}
+
+// generateOptionFixtures generates each of the optionFixtures.
+func generateOptionFixtures() error {
+	for _, f := range optionFixtures {
+		gen := tygojaPB.New(f.config)
+
+		result, err := gen.Generate()
+		if err != nil {
+			return err
+		}
+
+		if err := writeOptionFixture(f.name+".d.ts", result); err != nil {
+			return err
+		}
+
+		if warnings := gen.Warnings(); len(warnings) > 0 {
+			if err := writeOptionFixture(f.name+".warnings.txt", formatWarnings(warnings)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatWarnings returns the specified warnings as text lines
+// (the positions are with base file names to keep the output portable).
+func formatWarnings(warnings []tygojaPB.Warning) string {
+	var sb strings.Builder
+
+	for _, w := range warnings {
+		w.Position.Filename = filepath.Base(w.Position.Filename)
+		sb.WriteString(w.String())
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// writeOptionFixture writes the specified fixture file in the ./options directory.
+func writeOptionFixture(name string, content string) error {
+	return os.WriteFile(filepath.Join("./options", name), []byte(content), 0644)
+}
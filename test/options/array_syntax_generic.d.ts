@@ -0,0 +1,27 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Arrays with nested slices and slices of pointers
+   */
+  interface Arrays {
+    Matrix: Array<Array<number>>
+    Cube: Array<Array<Array<number>>>
+    Pointers: Array<(Pair | undefined)>
+    Nested: Array<Array<(Pair | undefined)>>
+    Funcs: Array<() => number>
+    Fixed: Array<Array<string>>
+  }
+}
+
+namespace d {
+  /**
+   * multiple fields struct that is not collapsed
+   */
+  interface Pair {
+    Key: string
+    Value: number
+  }
+}
@@ -0,0 +1,27 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Arrays with nested slices and slices of pointers
+   */
+  interface Arrays {
+    Matrix: number[][]
+    Cube: number[][][]
+    Pointers: (Pair | undefined)[]
+    Nested: (Pair | undefined)[][]
+    Funcs: (() => number)[]
+    Fixed: string[][]
+  }
+}
+
+namespace d {
+  /**
+   * multiple fields struct that is not collapsed
+   */
+  interface Pair {
+    Key: string
+    Value: number
+  }
+}
@@ -0,0 +1,26 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Permission is a bit flag
+   */
+  interface Permission extends Number{}
+  /**
+   * bit flag constants
+   */
+  const PermissionRead: Permission = 1
+  /**
+   * bit flag constants
+   */
+  const PermissionWrite: Permission = 2
+  /**
+   * bit flag constants
+   */
+  const PermissionExec: Permission = 4
+  /**
+   * constant expression with mixed operands
+   */
+  const PermissionAll = 7
+}
@@ -0,0 +1,14 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Enabled is a boolean constant
+   */
+  const Enabled = true
+  /**
+   * Disabled is a boolean constant expression
+   */
+  const Disabled = false
+}
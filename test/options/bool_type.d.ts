@@ -0,0 +1,44 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Active is a named bool
+   */
+  interface Active extends Boolean{}
+  /**
+   * Account with named basic, composite and struct types
+   */
+  interface Account {
+    Email: Email
+    Active: Active
+    Verified: 0 | 1
+    Flags: Array<0 | 1>
+    Name: string
+    Tags: Tags
+    Labels: Labels
+    Profile?: Profile
+  }
+}
+
+namespace d {
+  /**
+   * Email is a named string
+   */
+  interface Email extends String{}
+  /**
+   * Tags is a named slice
+   */
+  interface Tags extends Array<string>{}
+  /**
+   * Labels is a named map
+   */
+  interface Labels extends _TygojaDict{}
+  /**
+   * Profile is a named struct
+   */
+  interface Profile {
+    Bio: string
+  }
+}
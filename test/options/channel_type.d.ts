@@ -0,0 +1,26 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Event sent through the channels
+   */
+  interface Event {
+    Name: string
+  }
+  /**
+   * Pipes with all channel directions
+   */
+  interface Pipes {
+    Both: ReadableStream<Event>
+    Receive: ReadableStream<Event | undefined>
+    Send: ReadableStream<Array<Event>>
+  }
+  interface Pipes {
+    /**
+     * Subscribe returns a receive-only channel
+     */
+    Subscribe(topic: string): ReadableStream<Event>
+  }
+}
@@ -0,0 +1,33 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * single field struct collapsed to its field type
+   */
+  type Wrapper = Array<string>
+  /**
+   * single field generic struct
+   */
+  type GenericWrapper<T> = T
+  /**
+   * multiple fields struct that is not collapsed
+   */
+  interface Pair {
+    Key: string
+    Value: number
+  }
+  /**
+   * single field struct with methods that is not collapsed
+   */
+  interface WrapperWithMethods {
+    Value: string
+  }
+  interface WrapperWithMethods {
+    /**
+     * String method
+     */
+    String(): string
+  }
+}
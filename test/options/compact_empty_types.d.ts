@@ -0,0 +1,22 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Empty struct
+   */
+  interface Empty {}
+  /**
+   * WithEmpty with empty struct and zero length array fields
+   */
+  interface WithEmpty {
+    Marker: {}
+    Nested: {
+      Inner: {}
+    }
+    Empty: Empty
+    Zero: []
+    Set: { [key: string]: {} }
+  }
+}
@@ -0,0 +1,20 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Signal with complex number fields
+   */
+  interface Signal {
+    Sample: { real: number; imag: number }
+    Samples: Array<{ real: number; imag: number }>
+    Peak?: { real: number; imag: number }
+  }
+  interface Signal {
+    /**
+     * Scale returns the scaled sample
+     */
+    Scale(factor: { real: number; imag: number }): { real: number; imag: number }
+  }
+}
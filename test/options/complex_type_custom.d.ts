@@ -0,0 +1,20 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Signal with complex number fields
+   */
+  interface Signal {
+    Sample: [number, number]
+    Samples: Array<[number, number]>
+    Peak?: [number, number]
+  }
+  interface Signal {
+    /**
+     * Scale returns the scaled sample
+     */
+    Scale(factor: [number, number]): [number, number]
+  }
+}
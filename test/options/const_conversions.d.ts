@@ -0,0 +1,33 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Priority is a conversion target type
+   */
+  interface Priority extends Number{}
+  /**
+   * LowPriority is an untyped constant
+   */
+  const LowPriority = 1
+  /**
+   * HighPriority converts another constant
+   */
+  const HighPriority: Priority = 2
+  /**
+   * DefaultCode converts to a type from another package
+   */
+  const DefaultCode: keys.Code = 'default'
+  /**
+   * Parenthesized conversion
+   */
+  const MaxPriority: Priority = 10
+}
+
+namespace keys {
+  /**
+   * Code is a named string key type
+   */
+  interface Code extends String{}
+}
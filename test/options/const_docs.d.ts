@@ -0,0 +1,26 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Color is a string typed const group
+   */
+  interface Color extends String{}
+  /**
+   * Red documented member
+   */
+  const Red: Color = 'red'
+  /**
+   * Green trailing comment member
+   */
+  const Green: Color = 'green'
+  /**
+   * Blue without trailing comment
+   */
+  const Blue: Color = 'blue'
+  /**
+   * DefaultColor ungrouped constant
+   */
+  const DefaultColor = 'red'
+}
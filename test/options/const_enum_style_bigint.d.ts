@@ -0,0 +1,28 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Status is an integer enum
+   */
+  const enum Status {
+    /**
+     * StatusActive is the first status
+     */
+    StatusActive = 1,
+    /**
+     * trailing status doc
+     */
+    StatusInactive = 2,
+    StatusBanned = 3,
+  }
+  /**
+   * Size is a 64-bit integer enum candidate
+   */
+  interface Size extends BigInt{}
+  /**
+   * SizeMax exceeds the JS safe integer
+   */
+  const SizeMax: Size = 9223372036854775807n
+}
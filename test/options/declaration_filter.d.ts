@@ -0,0 +1,24 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Stable is always generated
+   */
+  interface Stable {
+    Value: string
+  }
+  interface Stable {
+    /**
+     * Run method that is generated
+     */
+    Run(): void
+  }
+  interface NewStable {
+    /**
+     * NewStable is generated
+     */
+    (): (Stable)
+  }
+}
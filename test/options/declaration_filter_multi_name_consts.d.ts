@@ -0,0 +1,15 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * MultiX and MultiY are declared with a single spec (only MultiX is filtered)
+   */
+  const MultiY = 2
+  /**
+   * MultiA and MultiB are grouped (only MultiA is filtered)
+   */
+  const MultiB = 'b'
+  const MultiC = 'c'
+}
@@ -0,0 +1,20 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+declare namespace tree {
+  /**
+   * Node references a type from another package
+   */
+  interface Node {
+    Code: keys.Code
+    Children: Array<(Node | undefined)>
+  }
+}
+
+declare namespace keys {
+  /**
+   * Code is a named string key type
+   */
+  interface Code extends String{}
+}
@@ -0,0 +1,20 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+export type _TygojaDict = { [key:string | number | symbol]: any; }
+export type _TygojaAny = any
+
+export namespace tree {
+  /**
+   * Node references a type from another package
+   */
+  export interface Node {
+    Code: keys.Code
+    Children: Array<(Node | undefined)>
+  }
+}
+
+export namespace keys {
+  /**
+   * Code is a named string key type
+   */
+  export interface Code extends String{}
+}
@@ -0,0 +1,15 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Dicts with any valued maps
+   */
+  interface Dicts {
+    Interface: Record<string, unknown>
+    Any: Record<string, unknown>
+    Nested: Record<string, Record<string, unknown>>
+    ByID: Record<number, unknown>
+  }
+}
@@ -0,0 +1,29 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * OldClient is replaced by Client.
+   * @deprecated use Client instead
+   * @since v1.2
+   */
+  interface OldClient {
+    /**
+     * Timeout in seconds.
+     * @deprecated use Deadline.
+     * @since 1.3
+     */
+    Timeout: number
+    Deadline: string
+  }
+  interface OldClient {
+    /**
+     * Ping checks the connection.
+     * 
+     * Kept only for compatibility.
+     * @deprecated no longer needed.
+     */
+    Ping(): boolean
+  }
+}
@@ -0,0 +1,62 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+type int = number
+type int8 = number
+type int16 = number
+type int32 = number
+type int64 = number
+type uint = number
+type uint8 = number
+type uint16 = number
+type uint32 = number
+type uint64 = number
+type float32 = number
+type float64 = number
+type complex64 = { real: number; imag: number }
+type complex128 = { real: number; imag: number }
+type uintptr = number
+type byte = number
+type rune = number
+
+namespace d {
+  /**
+   * Signal with complex number fields
+   */
+  interface Signal {
+    Sample: complex128
+    Samples: Array<complex64>
+    Peak?: complex128
+  }
+  interface Signal {
+    /**
+     * Scale returns the scaled sample
+     */
+    Scale(factor: complex128): complex128
+  }
+  /**
+   * Weight is a named numeric type with methods
+   */
+  interface Weight extends Number{}
+  interface Weight {
+    /**
+     * Kilograms returns the weight in kilograms
+     */
+    Kilograms(): float64
+  }
+  /**
+   * Reading with numeric fields
+   */
+  interface Reading {
+    Count: int
+    Ratio: float32
+    Size: uint64
+    Weights: Array<Weight>
+  }
+  interface Reading {
+    /**
+     * Scale returns the scaled ratio
+     */
+    Scale(factor: float64, ...times: int[]): float64
+  }
+}
@@ -0,0 +1,60 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+/**
+ * Package examples is used to check the generation of the Go testable examples.
+ */
+namespace examples {
+  /**
+   * Greeter greets people.
+   * @example
+   * ```go
+   * g := &examples.Greeter{Prefix: "Hello"}
+   * 
+   * fmt.Println(g.Prefix)
+   * // Output:
+   * // Hello
+   * ```
+   */
+  interface Greeter {
+    Prefix: string
+  }
+  interface Greeter {
+    /**
+     * Greet returns the greeting for the specified name.
+     * @example
+     * ```go
+     * g := &examples.Greeter{Prefix: "Hi"}
+     * 
+     * // greet a single person
+     * fmt.Println(g.Greet("John"))
+     * // Output:
+     * // Hi John
+     * ```
+     */
+    Greet(name: string): string
+  }
+  interface Sum {
+    /**
+     * Sum returns the sum of the specified numbers.
+     * @example
+     * ```go
+     * fmt.Println(examples.Sum(1, 2, 3))
+     * // Output:
+     * // 6
+     * ```
+     * @example
+     * Sum of the individual numbers.
+     * ```go
+     * for _, n := range []int{3, 1} {
+     * 	fmt.Println(examples.Sum(n))
+     * }
+     * // Unordered output:
+     * // 1
+     * // 3
+     * ```
+     */
+    (...nums: number[]): number
+  }
+}
@@ -0,0 +1,36 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace dotimport {
+  /**
+   * Local type declared in the current package
+   */
+  interface Local {
+    Value: string
+  }
+  /**
+   * WithDotImport with bare identifiers from a dot imported package
+   */
+  interface WithDotImport {
+    Pair: d.Pair
+    Wrapper?: d.Wrapper
+    Local: Local
+  }
+}
+
+namespace d {
+  /**
+   * single field struct collapsed to its field type
+   */
+  interface Wrapper {
+    Value: Array<string>
+  }
+  /**
+   * multiple fields struct that is not collapsed
+   */
+  interface Pair {
+    Key: string
+    Value: number
+  }
+}
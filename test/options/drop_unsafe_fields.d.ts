@@ -0,0 +1,14 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Handle with unsafe pointer fields
+   */
+  interface Handle {
+    Name: string
+    Ptrs: Array<any>
+    Addr: number
+  }
+}
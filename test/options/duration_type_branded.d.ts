@@ -0,0 +1,21 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+type _TygojaDuration = number & { readonly __brand: "Duration" }
+
+namespace d {
+  /**
+   * Timeout with duration fields
+   */
+  interface Timeout {
+    Delay: _TygojaDuration
+    Retries: Array<_TygojaDuration>
+    Max?: _TygojaDuration
+  }
+  interface Timeout {
+    /**
+     * Extend returns the extended delay
+     */
+    Extend(d: _TygojaDuration): _TygojaDuration
+  }
+}
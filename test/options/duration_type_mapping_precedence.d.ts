@@ -0,0 +1,20 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Timeout with duration fields
+   */
+  interface Timeout {
+    Delay: string
+    Retries: Array<string>
+    Max?: string
+  }
+  interface Timeout {
+    /**
+     * Extend returns the extended delay
+     */
+    Extend(d: string): string
+  }
+}
@@ -0,0 +1,20 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Timeout with duration fields
+   */
+  interface Timeout {
+    Delay: number
+    Retries: Array<number>
+    Max?: number
+  }
+  interface Timeout {
+    /**
+     * Extend returns the extended delay
+     */
+    Extend(d: number): number
+  }
+}
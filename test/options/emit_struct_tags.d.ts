@@ -0,0 +1,36 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Tagged with js struct tags
+   */
+  interface Tagged {
+    /**
+     * @gotag js:"customName" json:"jsonName"
+     */
+    Renamed: string
+    /**
+     * @gotag js:"withOptions,omitempty"
+     */
+    Options: string
+    /**
+     * @gotag js:"-"
+     */
+    Skipped: string
+    /**
+     * @gotag js:",omitempty"
+     */
+    NoName: string
+    Untagged: string
+    /**
+     * @gotag json:"jsonOnly"
+     */
+    JSONOnly: string
+    /**
+     * @gotag glob:"*\/*.go"
+     */
+    Pattern: string
+  }
+}
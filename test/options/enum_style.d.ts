@@ -0,0 +1,35 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Status is an integer enum
+   */
+  enum Status {
+    /**
+     * StatusActive is the first status
+     */
+    StatusActive = 1,
+    /**
+     * trailing status doc
+     */
+    StatusInactive = 2,
+    StatusBanned = 3,
+  }
+  /**
+   * Quote is a string enum with escaped values
+   */
+  enum Quote {
+    QuoteSingle = 'it\'s',
+    QuoteNewline = 'line\nbreak',
+    QuoteControl = '\u0007\u0000\\',
+    QuoteEmoji = '😀',
+  }
+  /**
+   * Size is a 64-bit integer enum candidate
+   */
+  enum Size {
+    SizeMax = 9223372036854775807,
+  }
+}
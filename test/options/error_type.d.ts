@@ -0,0 +1,20 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type GoError = Error & { value: any }
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Failable with error fields, params and results
+   */
+  interface Failable {
+    Err: GoError
+    Errors: Array<GoError>
+  }
+  interface Failable {
+    /**
+     * Wrap method with error params and results
+     */
+    Wrap(err: GoError, ...others: GoError[]): number
+  }
+}
@@ -0,0 +1,29 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Feature with excluded members
+   */
+  interface Feature {
+    Name: string
+    Legacy: string // kept since the trailing comment is not a doc comment [EXPERIMENTAL]
+  }
+  interface Feature {
+    /**
+     * Enable is kept
+     */
+    Enable(): void
+  }
+  /**
+   * Switcher with excluded interface method
+   */
+  interface Switcher {
+    [key:string]: any;
+    /**
+     * Reset is kept
+     */
+    Reset(): void
+  }
+}
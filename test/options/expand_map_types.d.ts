@@ -0,0 +1,15 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Dicts with any valued maps
+   */
+  interface Dicts {
+    Interface: { [key: string]: any }
+    Any: { [key: string]: any }
+    Nested: { [key: string]: { [key: string]: any } }
+    ByID: { [key: number]: any }
+  }
+}
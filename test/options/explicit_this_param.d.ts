@@ -0,0 +1,27 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Emitter with callback params
+   */
+  interface Emitter {
+  }
+  interface Emitter {
+    /**
+     * On with nested callback param types
+     */
+    On(this: Emitter, event: string, handler?: (data: string, extra: number) => void, priority?: number): void
+  }
+  /**
+   * Listener interface with callback params
+   */
+  interface Listener {
+    [key:string]: any;
+    /**
+     * Listen with nested callback param types
+     */
+    Listen(this: Listener, event: string, handler?: (data: string) => boolean, once?: boolean): void
+  }
+}
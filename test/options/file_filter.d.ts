@@ -0,0 +1,12 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace filefilter {
+  /**
+   * Kept is declared in a regular file
+   */
+  interface Kept {
+    Value: string
+  }
+}
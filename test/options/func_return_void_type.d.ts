@@ -0,0 +1,31 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Worker with func fields without results
+   */
+  interface Worker {
+    OnStart: () => undefined
+    OnStop: () => undefined
+  }
+  interface Worker {
+    /**
+     * Run method without results
+     */
+    Run(): undefined
+  }
+  interface Worker {
+    /**
+     * Close method with only an error result
+     */
+    Close(): undefined
+  }
+  interface Shutdown {
+    /**
+     * Shutdown function with only an error result
+     */
+    (force: boolean): undefined
+  }
+}
@@ -0,0 +1,21 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace client {
+  interface New {
+    /**
+     * New creates a new gRPC client
+     */
+    (host: string, port: number): string
+  }
+}
+
+namespace client {
+  interface New {
+    /**
+     * New creates a new HTTP client
+     */
+    (baseURL: string): string
+  }
+}
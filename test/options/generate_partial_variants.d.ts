@@ -0,0 +1,21 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * multiple fields struct that is not collapsed
+   */
+  interface Pair {
+    Key: string
+    Value: number
+  }
+  /**
+   * UpdatePayload with partial variant
+   */
+  interface UpdatePayload {
+    Name?: string
+    Count?: number
+  }
+  type UpdatePayloadPartial = Partial<UpdatePayload>
+}
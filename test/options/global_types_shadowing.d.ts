@@ -0,0 +1,33 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace shadowing {
+  /**
+   * Number constraint that shadows the JS global Number
+   */
+  type Number = number
+  /**
+   * Amount with Number base type
+   */
+  interface Amount extends globalThis.Number{}
+  interface Sum<T extends Number> {
+    /**
+     * Sum with Number constraint type param
+     */
+    (...values: T[]): T
+  }
+  /**
+   * Error that shadows the JS global Error
+   */
+  interface Error {
+    Code: number
+  }
+  /**
+   * Outcome with builtin error field
+   */
+  interface Outcome {
+    Err: globalThis.Error
+    Custom: Error
+  }
+}
@@ -0,0 +1,18 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Catalog with internal members
+   */
+  interface Catalog {
+    Name: string
+  }
+  interface Catalog {
+    /**
+     * Get is public.
+     */
+    Get(key: string): any
+  }
+}
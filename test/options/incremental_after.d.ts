@@ -0,0 +1,28 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace incremental {
+  /**
+   * Level type
+   */
+  interface Level extends Number{}
+  /**
+   * level constants
+   */
+  const LevelDebug: Level = 10
+  /**
+   * info level
+   */
+  const LevelInfo: Level = 11
+  /**
+   * DefaultLevel references another constant
+   */
+  const DefaultLevel = 11
+  /**
+   * Logger is unchanged between the runs
+   */
+  interface Logger {
+    Level: Level
+  }
+}
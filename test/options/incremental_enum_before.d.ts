@@ -0,0 +1,23 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace incremental {
+  /**
+   * Level type
+   */
+  enum Level {
+    LevelDebug = 0,
+    /**
+     * info level
+     */
+    LevelInfo = 1,
+    DefaultLevel = 1,
+  }
+  /**
+   * Logger is unchanged between the runs
+   */
+  interface Logger {
+    Level: Level
+  }
+}
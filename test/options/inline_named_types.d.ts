@@ -0,0 +1,28 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Account with named basic, composite and struct types
+   */
+  interface Account {
+    Email: string
+    Active: boolean
+    Verified: boolean
+    Flags: Array<boolean>
+    Name: string
+    Tags: Array<string>
+    Labels: _TygojaDict
+    Profile?: Profile
+  }
+}
+
+namespace d {
+  /**
+   * Profile is a named struct
+   */
+  interface Profile {
+    Bio: string
+  }
+}
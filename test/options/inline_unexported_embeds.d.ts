@@ -0,0 +1,19 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * User with unexported embedded structs
+   */
+  interface User {
+    Name: string
+    ID: number
+    Created: string
+    Updated: string
+    /**
+     * Touch method promoted from the unexported embedded struct
+     */
+    Touch(): void
+  }
+}
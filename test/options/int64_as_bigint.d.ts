@@ -0,0 +1,21 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Counter with 64-bit integer fields
+   */
+  interface Counter {
+    Total: bigint
+    Max: bigint
+    Small: number
+    ByTotal: { [key: number]: string }
+  }
+  interface Counter {
+    /**
+     * Add with 64-bit integer params
+     */
+    Add(delta: bigint, ...items: bigint[]): bigint
+  }
+}
@@ -0,0 +1,44 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Registry is an internal helper.
+   * @internal
+   */
+  interface Registry {
+    Items: Array<string>
+  }
+  /**
+   * Catalog with internal members
+   */
+  interface Catalog {
+    Name: string
+    /**
+     * Cache is an internal field.
+     * @internal
+     */
+    Cache: _TygojaDict
+  }
+  interface Catalog {
+    /**
+     * Reset is an internal method.
+     * @internal
+     */
+    Reset(): void
+  }
+  interface Catalog {
+    /**
+     * Get is public.
+     */
+    Get(key: string): any
+  }
+  interface NewRegistry {
+    /**
+     * NewRegistry is an internal function.
+     * @internal
+     */
+    (): (Registry)
+  }
+}
@@ -0,0 +1,14 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Payload with raw JSON fields
+   */
+  interface Payload {
+    Data: any
+    Optional?: any
+    Bytes: string|Array<number>
+  }
+}
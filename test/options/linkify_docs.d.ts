@@ -0,0 +1,43 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * {@link Article} is written by an {@link Author} and has a unique {@link Code}
+   * (unlike the [keys.Code] of the other package).
+   * 
+   * The `Author` code spans and the existing {@link Author} links are preserved.
+   */
+  interface Article {
+    /**
+     * {@link Author} of the article (see {@link Author}.Name).
+     */
+    Author?: Author
+    /**
+     * {@link Code} of the article.
+     */
+    Code: Code
+    /**
+     * Key referencing keys.Code.
+     */
+    Key: keys.Code
+  }
+  /**
+   * {@link Author} of an {@link Article}
+   */
+  interface Author {
+    Name: string
+  }
+  /**
+   * {@link Code} is a unique {@link Article} identifier
+   */
+  interface Code extends String{}
+}
+
+namespace keys {
+  /**
+   * {@link Code} is a named string key type
+   */
+  interface Code extends String{}
+}
@@ -0,0 +1,15 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Dicts with any valued maps
+   */
+  interface Dicts {
+    Interface: Map<string, any>
+    Any: Map<string, any>
+    Nested: Map<string, Map<string, any>>
+    ByID: Map<number, any>
+  }
+}
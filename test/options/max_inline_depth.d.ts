@@ -0,0 +1,16 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Deep with 5 levels of nested anonymous structs
+   */
+  interface Deep {
+    Level1: {
+      Level2: {
+        Level3: any /* inline.go:7 */
+      }
+    }
+  }
+}
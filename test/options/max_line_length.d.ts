@@ -0,0 +1,169 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Entity is a long type set union
+   */
+  type Entity = Account | Measurements | Indexes | Keywords | OldClient
+    | Feature | Tagged
+  /**
+   * Mailer with long method params
+   */
+  interface Mailer {
+  }
+  interface Mailer {
+    /**
+     * Send has params exceeding the max line length
+     */
+    Send(
+      from: string,
+      to: Array<string>,
+      subject: string,
+      body: string,
+      attachments: _TygojaDict,
+      priority: number
+    ): void
+  }
+  interface Mailer {
+    /**
+     * Ping has short params
+     */
+    Ping(host: string): boolean
+  }
+}
+
+namespace d {
+  /**
+   * Account with named basic, composite and struct types
+   */
+  interface Account {
+    Email: Email
+    Active: Active
+    Verified: boolean
+    Flags: Array<boolean>
+    Name: string
+    Tags: Tags
+    Labels: Labels
+    Profile?: Profile
+  }
+  /**
+   * OldClient is replaced by Client.
+   * 
+   * Deprecated: use Client instead. Since v1.2
+   */
+  interface OldClient {
+    /**
+     * Timeout in seconds.
+     * 
+     * Deprecated: as of 1.3, use Deadline.
+     */
+    Timeout: number
+    Deadline: string
+  }
+  interface OldClient {
+    /**
+     * Ping checks the connection.
+     * 
+     * Deprecated: no longer needed.
+     * 
+     * Kept only for compatibility.
+     */
+    Ping(): boolean
+  }
+  /**
+   * Measurements with all basic kinds
+   */
+  interface Measurements {
+    Count: number
+    Ratio: number
+    Signal: { real: number; imag: number }
+    Label: string
+    Ok: boolean
+    Sizes: Array<number>
+    Levels: _TygojaDict
+  }
+  /**
+   * Indexes with named map key types
+   */
+  interface Indexes {
+    ByEmail: _TygojaDict
+    BySlot: _TygojaDict
+    ByCode: _TygojaDict
+    ByCoord: _TygojaDict
+  }
+  /**
+   * Feature with excluded members
+   */
+  interface Feature {
+    Name: string
+    /**
+     * Beta is an excluded field [EXPERIMENTAL]
+     */
+    Beta: boolean
+    Legacy: string // kept since the trailing comment is not a doc comment [EXPERIMENTAL]
+  }
+  interface Feature {
+    /**
+     * Toggle is an excluded method
+     * 
+     * [EXPERIMENTAL]
+     */
+    Toggle(): void
+  }
+  interface Feature {
+    /**
+     * Enable is kept
+     */
+    Enable(): void
+  }
+  /**
+   * Keywords with reserved word field names
+   */
+  interface Keywords {
+    Class: string
+    Default: boolean
+    New: number
+    Await: string
+    Kind: string
+    Dashed: string
+  }
+  /**
+   * Tagged with js struct tags
+   */
+  interface Tagged {
+    Renamed: string
+    Options: string
+    Skipped: string
+    NoName: string
+    Untagged: string
+    JSONOnly: string
+    Pattern: string
+  }
+}
+
+namespace d {
+  /**
+   * Email is a named string
+   */
+  interface Email extends String{}
+  /**
+   * Active is a named bool
+   */
+  interface Active extends Boolean{}
+  /**
+   * Tags is a named slice
+   */
+  interface Tags extends Array<string>{}
+  /**
+   * Labels is a named map
+   */
+  interface Labels extends _TygojaDict{}
+  /**
+   * Profile is a named struct
+   */
+  interface Profile {
+    Bio: string
+  }
+}
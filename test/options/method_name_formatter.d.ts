@@ -0,0 +1,33 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * ReservedMethods with methods renamed by the
+   * MethodNameFormatter to reserved or invalid JS names
+   */
+  interface ReservedMethods {
+    [key:string]: any;
+    'delete'(key: string): void
+    'default'(): string
+    'content-type'(): string
+  }
+  /**
+   * Resource with struct methods renamed to invalid JS names
+   */
+  interface Resource {
+  }
+  interface Resource {
+    /**
+     * ContentType returns the resource content type
+     */
+    'content-type'(): string
+  }
+  interface Resource {
+    /**
+     * New is a renamed reserved word method
+     */
+    'new'(): (Resource)
+  }
+}
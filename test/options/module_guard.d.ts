@@ -0,0 +1,15 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+declare global { var $event: d.Event }
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Event sent through the channels
+   */
+  interface Event {
+    Name: string
+  }
+}
+
+export {};
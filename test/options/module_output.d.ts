@@ -0,0 +1,74 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+export type _TygojaDict = { [key:string | number | symbol]: any; }
+export type _TygojaAny = any
+
+/**
+ * package b
+ */
+export namespace b {
+  /**
+   * struct with qualified generic fields from another package
+   */
+  export interface Container {
+    Repos: Array<a.Repo<a.ID>>
+    Pair?: a.Pair<string, a.StructC<string, number, boolean>, any>
+  }
+}
+
+export namespace d {
+  /**
+   * single field struct collapsed to its field type
+   */
+  export interface Wrapper {
+    Value: Array<string>
+  }
+}
+
+/**
+ * package a docs
+ * lorem ipsum dolor...
+ */
+export namespace a {
+  /**
+   * structC with multiple mixed generic types
+   */
+  export interface StructC<A,B,C> {
+    Field4: A
+    Field5: B
+    Field6: C
+  }
+  export interface StructC<A,B,C> {
+    /**
+     * StructC.Method4 comment
+     */
+    Method4(arg1: A): [B, C]
+  }
+  /**
+   * ID comment
+   */
+  export interface ID extends String{}
+  /**
+   * Repo combines generics, pointer receiver and variadic method params
+   */
+  export interface Repo<T> {
+  }
+  export interface Repo<T> {
+    /**
+     * Repo.Find comment
+     */
+    Find(...ids: ID[]): Array<T>
+  }
+  /**
+   * Pair with renamed and blank receiver type params
+   */
+  export interface Pair<K,V,_T2> {
+    Key: K
+    Value: V
+  }
+  export interface Pair<K,V,_T2> {
+    /**
+     * Pair.Get comment
+     */
+    Get(key: K): V
+  }
+}
@@ -0,0 +1,24 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Reader named interface
+   */
+  interface Reader {
+    [key:string]: any;
+    Read(p: string|Array<number>): number
+  }
+  /**
+   * Streams with named interface and identical interface literals
+   */
+  interface Streams {
+    Named: Reader
+    Literal: Reader
+    Literals: Array<Reader>
+    Other: {
+      Close(): void
+    }
+  }
+}
@@ -0,0 +1,15 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Indexes with named map key types
+   */
+  interface Indexes {
+    ByEmail: { [key: string]: string }
+    BySlot: { [key: number]: boolean }
+    ByCode: { [key: string]: number }
+    ByCoord: _TygojaDict
+  }
+}
@@ -0,0 +1,40 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Lookup with named results methods
+   */
+  interface Lookup {
+  }
+  interface Lookup {
+    /**
+     * Find searches for the specified key.
+     * @returns [value, found]
+     *  - value: the found value
+     *  - found: whether the key exists
+     */
+    Find(key: string): [string, boolean]
+  }
+  interface Lookup {
+    /**
+     * Bounds returns the lookup bounds.
+     * @returns [min, max]
+     */
+    Bounds(): [number, number]
+  }
+  interface Split {
+    /**
+     * Split splits the path without named results.
+     */
+    (path: string): [string, string]
+  }
+  interface Parse {
+    /**
+     * Parse parses the raw value.
+     * @returns [value, rest]
+     */
+    (raw: string): [number, string]
+  }
+}
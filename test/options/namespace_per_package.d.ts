@@ -0,0 +1,74 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+/**
+ * package b
+ */
+declare namespace b {
+  /**
+   * struct with qualified generic fields from another package
+   */
+  interface Container {
+    Repos: Array<a.Repo<a.ID>>
+    Pair?: a.Pair<string, a.StructC<string, number, boolean>, any>
+  }
+}
+
+declare namespace d {
+  /**
+   * single field struct collapsed to its field type
+   */
+  interface Wrapper {
+    Value: Array<string>
+  }
+}
+
+/**
+ * package a docs
+ * lorem ipsum dolor...
+ */
+declare namespace a {
+  /**
+   * structC with multiple mixed generic types
+   */
+  interface StructC<A,B,C> {
+    Field4: A
+    Field5: B
+    Field6: C
+  }
+  interface StructC<A,B,C> {
+    /**
+     * StructC.Method4 comment
+     */
+    Method4(arg1: A): [B, C]
+  }
+  /**
+   * ID comment
+   */
+  interface ID extends String{}
+  /**
+   * Repo combines generics, pointer receiver and variadic method params
+   */
+  interface Repo<T> {
+  }
+  interface Repo<T> {
+    /**
+     * Repo.Find comment
+     */
+    Find(...ids: ID[]): Array<T>
+  }
+  /**
+   * Pair with renamed and blank receiver type params
+   */
+  interface Pair<K,V,_T2> {
+    Key: K
+    Value: V
+  }
+  interface Pair<K,V,_T2> {
+    /**
+     * Pair.Get comment
+     */
+    Get(key: K): V
+  }
+}
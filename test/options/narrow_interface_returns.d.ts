@@ -0,0 +1,47 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Store interface
+   */
+  interface Store {
+    [key:string]: any;
+    Get(key: string): string
+  }
+  /**
+   * MemoryStore implements Store
+   */
+  interface MemoryStore {
+  }
+  interface MemoryStore {
+    Get(key: string): string
+  }
+  /**
+   * FileStore implements Store
+   */
+  interface FileStore {
+  }
+  interface FileStore {
+    Get(key: string): string
+  }
+  interface NewMemoryStore {
+    /**
+     * NewMemoryStore is narrowed to MemoryStore
+     */
+    (): (MemoryStore)
+  }
+  interface NewStore {
+    /**
+     * NewStore is not narrowed because of the different returned types
+     */
+    (inMemory: boolean): Store
+  }
+  interface NewCheckedStore {
+    /**
+     * NewCheckedStore is not narrowed because of the non composite literal return
+     */
+    (ok: boolean): Store
+  }
+}
@@ -0,0 +1,40 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Hooks with nested anonymous types
+   */
+  interface Hooks {
+    OnResult: () => {
+      Code: number
+    }
+    OnPair: () => [{
+      A: number
+    }, {
+      B: string
+    }]
+    Source: {
+      Get(): {
+        Value(): number
+      }
+    }
+  }
+  interface Hooks {
+    /**
+     * Factory returns anonymous types
+     */
+    Factory(): () => {
+      Name: string
+    }
+  }
+  interface NewHooks {
+    /**
+     * NewHooks is a package function with anonymous result
+     */
+    (): {
+      Close(): void
+    }
+  }
+}
@@ -0,0 +1,32 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type GoNumber = number
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Weight is a named numeric type with methods
+   */
+  interface Weight extends Number{}
+  interface Weight {
+    /**
+     * Kilograms returns the weight in kilograms
+     */
+    Kilograms(): GoNumber
+  }
+  /**
+   * Reading with numeric fields
+   */
+  interface Reading {
+    Count: GoNumber
+    Ratio: GoNumber
+    Size: GoNumber
+    Weights: Array<Weight>
+  }
+  interface Reading {
+    /**
+     * Scale returns the scaled ratio
+     */
+    Scale(factor: GoNumber, ...times: GoNumber[]): GoNumber
+  }
+}
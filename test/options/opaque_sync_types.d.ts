@@ -0,0 +1,14 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Cache with opaque sync types
+   */
+  interface Cache {
+    Items: _TygojaDict
+    Mu: any
+    Once?: never
+  }
+}
@@ -0,0 +1,35 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  interface Connect {
+    /**
+     * Connect with optional trailing params
+     */
+    (dsn: string, timeout?: number, retries?: number): void
+  }
+  interface Log {
+    /**
+     * Log with optional params before the variadic one
+     */
+    (format: string, level?: number, ...args: any[]): void
+  }
+  interface Open {
+    /**
+     * Open with invalid directive value
+     */
+    (path: string, flag: number): void
+  }
+  /**
+   * Client with optional method params
+   */
+  interface Client {
+  }
+  interface Client {
+    /**
+     * Get with all params optional
+     */
+    Get(key?: string, fallback?: string): string
+  }
+}
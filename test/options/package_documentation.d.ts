@@ -0,0 +1,27 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+/**
+ * Package documented has package doc comments in multiple files.
+ * 
+ * Only the first one is tagged as package documentation.
+ * @packageDocumentation
+ */
+/**
+ * Package documented second doc comment.
+ */
+namespace documented {
+  /**
+   * Item of the documented package
+   */
+  interface Item {
+    ID: string
+  }
+  /**
+   * Other of the documented package
+   */
+  interface Other {
+    Item: Item
+  }
+}
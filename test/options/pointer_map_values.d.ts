@@ -0,0 +1,38 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+/**
+ * package b
+ */
+namespace b {
+  /**
+   * struct with pointer elements
+   */
+  interface Registry {
+    List: Array<(Model | undefined)>
+    Map: { [key: string]: Model | undefined }
+  }
+  interface Registry {
+    /**
+     * Add method with pointer variadic params
+     */
+    Add(...models: (Model | undefined)[]): void
+  }
+}
+
+/**
+ * package b
+ */
+namespace b {
+  /**
+   * struct with sometimes present fields
+   */
+  interface Model {
+    Id: string
+    /**
+     * the relations are loaded only on demand
+     */
+    Expand?: { [key: string]: any }
+  }
+}
@@ -0,0 +1,17 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Keywords with reserved word field names
+   */
+  interface Keywords {
+    'class': string
+    'default': boolean
+    'new': number
+    'await': string
+    kind: string
+    'dashed-name': string
+  }
+}
@@ -0,0 +1,36 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Account with named basic, composite and struct types
+   */
+  interface Account {
+    Email: string
+    Active: boolean
+    Verified: boolean
+    Flags: Array<boolean>
+    Name: string
+    Tags: Tags
+    Labels: Labels
+    Profile?: Profile
+  }
+}
+
+namespace d {
+  /**
+   * Tags is a named slice
+   */
+  interface Tags extends Array<string>{}
+  /**
+   * Labels is a named map
+   */
+  interface Labels extends _TygojaDict{}
+  /**
+   * Profile is a named struct
+   */
+  interface Profile {
+    Bio: string
+  }
+}
@@ -0,0 +1,43 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Order references types declared after it
+   */
+  interface Order {
+    Customer?: Customer
+    Items: Array<Item>
+  }
+  /**
+   * Item of an order
+   */
+  interface Item {
+    Name: string
+  }
+  /**
+   * Customer references a type only with its method
+   */
+  interface Customer {
+    Address: Address
+  }
+  interface Customer {
+    /**
+     * LastInvoice returns the customer last invoice
+     */
+    LastInvoice(): (Invoice)
+  }
+  /**
+   * Address of a customer
+   */
+  interface Address {
+    City: string
+  }
+  /**
+   * Invoice is referenced only by a method
+   */
+  interface Invoice {
+    Total: number
+  }
+}
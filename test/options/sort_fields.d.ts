@@ -0,0 +1,17 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Tagged with js struct tags
+   */
+  interface Tagged {
+    JSONOnly: string
+    NoName: string
+    Pattern: string
+    Untagged: string
+    customName: string
+    withOptions: string
+  }
+}
@@ -0,0 +1,46 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+
+type GoString = string
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Email is a named string
+   */
+  interface Email extends String{}
+  /**
+   * Account with named basic, composite and struct types
+   */
+  interface Account {
+    Email: Email
+    Active: Active
+    Verified: boolean
+    Flags: Array<boolean>
+    Name: GoString
+    Tags: Tags
+    Labels: Labels
+    Profile?: Profile
+  }
+}
+
+namespace d {
+  /**
+   * Active is a named bool
+   */
+  interface Active extends Boolean{}
+  /**
+   * Tags is a named slice
+   */
+  interface Tags extends Array<GoString>{}
+  /**
+   * Labels is a named map
+   */
+  interface Labels extends _TygojaDict{}
+  /**
+   * Profile is a named struct
+   */
+  interface Profile {
+    Bio: GoString
+  }
+}
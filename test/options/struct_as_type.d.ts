@@ -0,0 +1,28 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+export namespace d {
+  /**
+   * multiple fields struct that is not collapsed
+   */
+  export type Pair = {
+    Key: string
+    Value: number
+  }
+  /**
+   * single field struct with methods that is not collapsed
+   */
+  export type WrapperWithMethods = {
+    Value: string
+    /**
+     * String method
+     */
+    String(): string
+  }
+  /**
+   * Empty struct
+   */
+  export type Empty = {
+  }
+}
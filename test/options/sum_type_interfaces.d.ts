@@ -0,0 +1,38 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Result is implemented by a closed set of structs
+   */
+  interface Result {
+    [key:string]: any;
+    IsResult(): boolean
+  }
+  type ResultUnion = (Failure & { kind: "Failure" }) | (Success & { kind: "Success" })
+  /**
+   * Success implements Result with value receiver
+   */
+  interface Success {
+    Data: string
+  }
+  interface Success {
+    IsResult(): boolean
+  }
+  /**
+   * Failure implements Result with pointer receiver
+   */
+  interface Failure {
+    Message: string
+  }
+  interface Failure {
+    IsResult(): boolean
+  }
+  /**
+   * Other doesn't implement Result
+   */
+  interface Other {
+    Data: string
+  }
+}
@@ -0,0 +1,26 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Setting with JSDoc struct tags
+   */
+  interface Setting {
+    /**
+     * Key of the setting
+     * @readonly
+     * @since 1.2
+     */
+    Key: string
+    /**
+     * @example {"enabled": true}
+     */
+    Value: any
+    /**
+     * @deprecated use Value instead
+     */
+    Comment: string
+    Plain: string
+  }
+}
@@ -0,0 +1,55 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Item of an order
+   */
+  interface Item {
+    Name: string
+  }
+  /**
+   * Address of a customer
+   */
+  interface Address {
+    City: string
+  }
+  /**
+   * Customer references a type only with its method
+   */
+  interface Customer {
+    Address: Address
+  }
+  /**
+   * Order references types declared after it
+   */
+  interface Order {
+    Customer?: Customer
+    Items: Array<Item>
+  }
+  /**
+   * Invoice is referenced only by a method
+   */
+  interface Invoice {
+    Total: number
+  }
+  interface Customer {
+    /**
+     * LastInvoice returns the customer last invoice
+     */
+    LastInvoice(): (Invoice)
+  }
+  /**
+   * Parent and Child are cyclic
+   */
+  interface Parent {
+    Children: Array<(Child | undefined)>
+  }
+  /**
+   * Child of a parent
+   */
+  interface Child {
+    Parent?: Parent
+  }
+}
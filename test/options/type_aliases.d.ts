@@ -0,0 +1,28 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * KeyValue generic type alias with inline struct
+   */
+  type KeyValue<A,B> = {
+    First: A
+    Second: B
+  }
+  /**
+   * PairAlias type alias
+   */
+  type PairAlias = Pair
+  /**
+   * Entries generic type alias
+   */
+  type Entries<T> = Array<KeyValue<string, T>>
+  /**
+   * multiple fields struct that is not collapsed
+   */
+  interface Pair {
+    Key: string
+    Value: number
+  }
+}
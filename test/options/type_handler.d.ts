@@ -0,0 +1,49 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Account with named basic, composite and struct types
+   */
+  interface Account {
+    Email: `${string}@${string}`
+    Active: Active
+    Verified: boolean
+    Flags: Readonly<Array<boolean>>
+    Name: string
+    Tags: Tags
+    Labels: Labels
+    Profile?: Profile
+  }
+  /**
+   * Dicts with any valued maps
+   */
+  interface Dicts {
+    Interface: Map<string, any>
+    Any: Map<string, any>
+    Nested: Map<string, Map<string, any>>
+    ByID: Map<number, any>
+  }
+}
+
+namespace d {
+  /**
+   * Active is a named bool
+   */
+  interface Active extends Boolean{}
+  /**
+   * Tags is a named slice
+   */
+  interface Tags extends Readonly<Array<string>>{}
+  /**
+   * Labels is a named map
+   */
+  interface Labels extends Map<string, `${string}@${string}`>{}
+  /**
+   * Profile is a named struct
+   */
+  interface Profile {
+    Bio: string
+  }
+}
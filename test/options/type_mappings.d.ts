@@ -0,0 +1,25 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * struct with mapped types nested in slices, maps and pointers
+   */
+  interface MappedElements {
+    Single: MyTSType
+    Pointer?: MyTSType
+    Slice: Array<MyTSType>
+    Pointers: Array<(MyTSType | undefined)>
+    Array: Array<MyTSType>
+    Map: { [key: string]: MyTSType }
+    Nested: Array<Array<MyTSType>>
+    Bytes: Array<MyByte>
+  }
+  interface MappedElements {
+    /**
+     * Variadic with mapped variadic elements
+     */
+    Variadic(...items: MyTSType[]): Array<MyTSType>
+  }
+}
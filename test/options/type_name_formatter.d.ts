@@ -0,0 +1,89 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+/**
+ * package b
+ */
+namespace b {
+  /**
+   * struct with qualified generic fields from another package
+   */
+  interface GoContainer {
+    Repos: Array<a.GoRepo<a.GoID>>
+    Pair?: a.GoPair<string, a.GoStructC<string, number, boolean>, any>
+  }
+}
+
+namespace d {
+  /**
+   * Store interface
+   */
+  interface GoStore {
+    [key:string]: any;
+    Get(key: string): string
+  }
+  /**
+   * MemoryStore implements Store
+   */
+  interface GoMemoryStore {
+  }
+  interface GoMemoryStore {
+    Get(key: string): string
+  }
+  interface newMemoryStore {
+    /**
+     * NewMemoryStore is narrowed to MemoryStore
+     */
+    (): GoStore
+  }
+}
+
+/**
+ * package a docs
+ * lorem ipsum dolor...
+ */
+namespace a {
+  /**
+   * structC with multiple mixed generic types
+   */
+  interface GoStructC<A,B,C> {
+    Field4: A
+    Field5: B
+    Field6: C
+  }
+  interface GoStructC<A,B,C> {
+    /**
+     * StructC.Method4 comment
+     */
+    Method4(arg1: A): [B, C]
+  }
+  /**
+   * ID comment
+   */
+  interface GoID extends String{}
+  /**
+   * Repo combines generics, pointer receiver and variadic method params
+   */
+  interface GoRepo<T> {
+  }
+  interface GoRepo<T> {
+    /**
+     * Repo.Find comment
+     */
+    Find(...ids: GoID[]): Array<T>
+  }
+  /**
+   * Pair with renamed and blank receiver type params
+   */
+  interface GoPair<K,V,_T2> {
+    Key: K
+    Value: V
+  }
+  interface GoPair<K,V,_T2> {
+    /**
+     * Pair.Get comment
+     */
+    Get(key: K): V
+  }
+}
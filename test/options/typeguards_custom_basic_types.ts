@@ -0,0 +1,17 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+
+export function isDMeasurements(x: unknown): x is d.Measurements {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    v.Count !== undefined &&
+    v.Ratio !== undefined &&
+    v.Signal !== undefined &&
+    v.Label !== undefined &&
+    v.Ok !== undefined &&
+    Array.isArray(v.Sizes) &&
+    typeof v.Levels === "object" && v.Levels !== null
+  )
+}
@@ -0,0 +1,17 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+
+export function isDMeasurements(x: unknown): x is d.Measurements {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    typeof v.Count === "bigint" &&
+    typeof v.Ratio === "number" &&
+    v.Signal !== undefined &&
+    typeof v.Label === "string" &&
+    typeof v.Ok === "boolean" &&
+    Array.isArray(v.Sizes) && v.Sizes.every((e0) => typeof e0 === "bigint") &&
+    typeof v.Levels === "object" && v.Levels !== null && Object.values(v.Levels).every((e0) => typeof e0 === "number")
+  )
+}
@@ -0,0 +1,15 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Handle with unsafe pointer fields
+   */
+  interface Handle {
+    Name: string
+    Ptr: any
+    Ptrs: Array<any>
+    Addr: number
+  }
+}
@@ -0,0 +1,17 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Buffer with variadic byte params
+   */
+  interface Buffer {
+  }
+  interface Buffer {
+    /**
+     * Write with variadic byte params
+     */
+    Write(...data: number[]): number
+  }
+}
@@ -0,0 +1,18 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace d {
+  /**
+   * Imaginary is a complex constant
+   */
+  const Imaginary: { real: number; imag: number }
+  /**
+   * complex constants group
+   */
+  const Unit: { real: number; imag: number }
+  /**
+   * complex constants group
+   */
+  const Rotation: { real: number; imag: number }
+}
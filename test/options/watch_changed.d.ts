@@ -0,0 +1,20 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace watched {
+  /**
+   * Task is edited while watching
+   */
+  interface Task {
+    Title: string
+    Status: Status
+  }
+}
+
+namespace watched {
+  /**
+   * Status of a task
+   */
+  interface Status extends String{}
+}
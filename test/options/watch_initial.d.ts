@@ -0,0 +1,12 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+type _TygojaDict = { [key:string | number | symbol]: any; }
+type _TygojaAny = any
+
+namespace watched {
+  /**
+   * Task is edited while watching
+   */
+  interface Task {
+    Title: string
+  }
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/hanzoai/tygojaPB"
+)
+
+// treeDir is the GenerateTree fixture output directory.
+const treeDir = "./tree"
+
+// generateTreeFixture writes the GenerateTree files of a
+// module mode config with cross package references in ./tree.
+func generateTreeFixture() error {
+	files, err := tygojaPB.New(tygojaPB.Config{
+		Packages:        map[string][]string{fixturesPkg + "/tree": {"*"}},
+		DeclarationMode: tygojaPB.DeclarationModeModule,
+	}).GenerateTree()
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(treeDir); err != nil {
+		return err
+	}
+
+	for name, content := range files {
+		path := filepath.Join(treeDir, name)
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
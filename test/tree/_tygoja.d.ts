@@ -0,0 +1,3 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+export type _TygojaDict = { [key:string | number | symbol]: any; }
+export type _TygojaAny = any
@@ -0,0 +1,10 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+import type { _TygojaDict, _TygojaAny } from "../../../../../../_tygoja"
+import type { tree } from "../tree/index"
+
+export namespace keys {
+  /**
+   * Code is a named string key type
+   */
+  export interface Code extends String{}
+}
@@ -0,0 +1,13 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+import type { _TygojaDict, _TygojaAny } from "../../../../../../_tygoja"
+import type { keys } from "../keys/index"
+
+export namespace tree {
+  /**
+   * Node references a type from another package
+   */
+  export interface Node {
+    Code: keys.Code
+    Children: Array<(Node | undefined)>
+  }
+}
@@ -0,0 +1,43 @@
+package main
+
+import "github.com/hanzoai/tygojaPB"
+
+// typeGuardFixtures describes the GenerateTypeGuards fixtures of
+// the type related options that affect the runtime checks.
+//
+// Each fixture is generated in ./options/typeguards_{name}.ts.
+var typeGuardFixtures = []optionFixture{
+	{
+		name: "int64_as_bigint",
+		config: tygojaPB.Config{
+			Packages:      map[string][]string{fixturesPkg: {"Measurements"}},
+			Int64AsBigInt: true,
+		},
+	},
+	{
+		name: "custom_basic_types",
+		config: tygojaPB.Config{
+			Packages:    map[string][]string{fixturesPkg: {"Measurements"}},
+			NumberType:  "GoNumber",
+			StringType:  "GoString",
+			BoolType:    "0 | 1",
+			ComplexType: "[number, number]",
+		},
+	},
+}
+
+// generateTypeGuardFixtures generates each of the typeGuardFixtures.
+func generateTypeGuardFixtures() error {
+	for _, f := range typeGuardFixtures {
+		result, err := tygojaPB.New(f.config).GenerateTypeGuards()
+		if err != nil {
+			return err
+		}
+
+		if err := writeOptionFixture("typeguards_"+f.name+".ts", result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// GENERATED CODE - DO NOT MODIFY BY HAND
+
+export function isAPadded(x: unknown): x is a.Padded {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    typeof v.X === "number"
+  )
+}
+
+export function isANested(x: unknown): x is a.Nested {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    v.Result !== undefined &&
+    v.Mapping !== undefined
+  )
+}
+
+export function isBContainer(x: unknown): x is b.Container {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    Array.isArray(v.Repos)
+  )
+}
+
+export function isBCollections(x: unknown): x is b.Collections {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    typeof v.Map === "object" && v.Map !== null && Object.values(v.Map).every((e0) => typeof e0 === "number") &&
+    (v.MapPtr === undefined || v.MapPtr === null || typeof v.MapPtr === "object" && v.MapPtr !== null && Object.values(v.MapPtr).every((e0) => typeof e0 === "number")) &&
+    Array.isArray(v.Slice) && v.Slice.every((e0) => typeof e0 === "number") &&
+    (v.SlicePtr === undefined || v.SlicePtr === null || Array.isArray(v.SlicePtr) && v.SlicePtr.every((e0) => typeof e0 === "number"))
+  )
+}
+
+export function isBLayered(x: unknown): x is b.Layered {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    typeof v.Name === "string"
+  )
+}
+
+export function isBOverrides(x: unknown): x is b.Overrides {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    v.Custom !== undefined
+  )
+}
+
+export function isBModel(x: unknown): x is b.Model {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    typeof v.Id === "string" &&
+    (v.Expand === undefined || v.Expand === null || typeof v.Expand === "object" && v.Expand !== null)
+  )
+}
+
+export function isBIgnored(x: unknown): x is b.Ignored {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    typeof v.Visible === "string"
+  )
+}
+
+export function isBJob(x: unknown): x is b.Job {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    v.Timeout !== undefined
+  )
+}
+
+export function isBRegistry(x: unknown): x is b.Registry {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    Array.isArray(v.List) &&
+    typeof v.Map === "object" && v.Map !== null
+  )
+}
+
+export function isBPointers(x: unknown): x is b.Pointers {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    (v.SlicePtr === undefined || v.SlicePtr === null || Array.isArray(v.SlicePtr) && v.SlicePtr.every((e0) => typeof e0 === "number"))
+  )
+}
+
+export function isCExample2(x: unknown): x is c.Example2 {
+  if (typeof x !== "object" || x === null) {
+    return false
+  }
+  const v = x as Record<string, unknown>
+  return (
+    typeof v.Title === "string" &&
+    v.Json !== undefined &&
+    (typeof v.Bytes === "string" || Array.isArray(v.Bytes))
+  )
+}
@@ -1,67 +1,8 @@
 // GENERATED CODE - DO NOT MODIFY BY HAND
-declare var $app: c.Handler;type _TygojaDict = { [key:string | number | symbol]: any; }
+declare var $app: c.Handler;declare var $ticker: time.Ticker
+type _TygojaDict = { [key:string | number | symbol]: any; }
 type _TygojaAny = any
 
-namespace c {
-  /**
-   * func type comment
-   */
-  interface Handler {(): string  } // after
-  /**
-   * Example:
-   * 
-   * ```
-   * 	Some
-   * 	code
-   * 	sample
-   * ```
-   */
-  interface Example2 {
-    Title: string
-    Json: Raw
-    Bytes: string|Array<number> // should be union
-  }
-  interface Example2 {
-    DemoEx2(): time.Time
-  }
-  interface Example2 {
-    /**
-     * Pointer as argument vs return type
-     */
-    DemoEx3(arg: Example1): (Example1)
-  }
-  interface Example2 {
-    /**
-     * ommited types
-     */
-    DemoEx4(n1: string, n2: string, n3: string): void
-  }
-  interface Example2 {
-    /**
-     * ommited names
-     */
-    DemoEx5(_arg0: string, _arg1: number): void
-  }
-  interface Example2 {
-    /**
-     * named return values
-     */
-    DemoEx6(): [number, string]
-  }
-  interface Example2 {
-    /**
-     * shortened return values
-     */
-    DemoEx7(): [string, string]
-  }
-  interface Example2 {
-    /**
-     * named and shortened return values
-     */
-    DemoEx8(): [number, string, string]
-  }
-}
-
 /**
  * package a docs
  * lorem ipsum dolor...
@@ -75,9 +16,6 @@ namespace a {
    */
   interface interfaceA<T> {
     [key:string]: any;
-    /**
-     * some comment
-     */
     /**
      * some comment above the function
      */
@@ -96,7 +34,7 @@ namespace a {
    * line
    * comment
    */
-  interface InterfaceB {
+  interface InterfaceB extends Empty, interfaceA<number> {
     [key:string]: any;
     /**
      * "replace" Method0 from interfaceA
@@ -104,6 +42,15 @@ namespace a {
     Method0(): void
     CustomMethod(): time.Time
   }
+  /**
+   * generic interface with type params referenced in the methods
+   */
+  interface Store<K,V> {
+    [key:string]: any;
+    Get(key: K): V
+    Each(fn: (key: K, value: V) => boolean): void
+    Values(): Array<V>
+  }
   interface unexported {
     Field1: string
   }
@@ -136,6 +83,12 @@ namespace a {
   interface StructB<T> extends _sOZEzeB {
     Field3: T
   }
+  interface StructB<T> {
+    /**
+     * StructB.Method3 comment
+     */
+    Method3(arg1: number): [number, string]
+  }
   /**
    * structC with multiple mixed generic types
    */
@@ -144,22 +97,98 @@ namespace a {
     Field5: B
     Field6: C
   }
-  interface StructC<A, B, C> {
+  interface StructC<A,B,C> {
     /**
      * StructC.Method4 comment
      */
     Method4(arg1: A): [B, C]
   }
+  /**
+   * ID comment
+   */
+  interface ID extends String{}
+  /**
+   * Repo combines generics, pointer receiver and variadic method params
+   */
+  interface Repo<T> {
+  }
+  interface Repo<T> {
+    /**
+     * Repo.Find comment
+     */
+    Find(...ids: ID[]): Array<T>
+  }
+  /**
+   * Pair with renamed and blank receiver type params
+   */
+  interface Pair<K,V,_T2> {
+    Key: K
+    Value: V
+  }
+  interface Pair<K,V,_T2> {
+    /**
+     * Pair.Get comment
+     */
+    Get(key: K): V
+  }
+  /**
+   * Padded with blank padding fields
+   */
+  interface Padded {
+    X: number
+  }
+  interface Padded {
+    /**
+     * Padded.Set with blank params
+     */
+    Set(_arg00: number, x: number, _arg20: string): void
+  }
+  /**
+   * List generic struct
+   */
+  interface List<T> {
+    Items: Array<T>
+  }
+  /**
+   * Result generic struct
+   */
+  interface Result<T> {
+    Data: T
+    Error: string
+  }
+  /**
+   * Nested with nested generic instantiations
+   */
+  interface Nested {
+    Result: Result<List<ID>>
+    Mapping: Pair<string, List<number>, Result<List<ID>>>
+  }
   /**
    * type comment
    */
   interface SliceAlias<T> extends Array<T>{} // after
+  /**
+   * IDs is a list of record ids.
+   */
+  interface IDs extends Array<string>{}
+  interface IDs {
+    /**
+     * Contains checks whether the list contains the specified id.
+     */
+    Contains(id: string): boolean
+  }
+  interface IDs {
+    /**
+     * Join returns the ids as a single string.
+     */
+    Join(sep: string): string
+  }
   /**
    * multi
    * line
    * comment
    */
-  interface Handler<T> {(): [T, number]  } // after
+  type Handler<T> = () => [T, number] // after
 }
 
 /**
@@ -180,7 +209,7 @@ namespace b {
      */
     (arg1: number): T
   }
-  interface Func3<A,B,C> {
+  interface Func3<A extends string,B,C> {
     /**
      * function with multiple generic types
      */
@@ -228,6 +257,261 @@ namespace b {
      */
     (): [number, string, string]
   }
+  interface Func11 {
+    /**
+     * function with higher-order function param and result
+     */
+    (cb: () => () => void): () => (_arg0: number) => void
+  }
+  interface Func12 {
+    /**
+     * function with byte slice results
+     */
+    (): string|Array<number>
+  }
+  interface Func13 {
+    /**
+     * function with multiple byte slice results
+     */
+    (data: string|Array<number>): [string|Array<number>, (string|Array<number>)]
+  }
+  interface Func14 {
+    /**
+     * function with error only result
+     */
+    (): void
+  }
+  interface Func15 {
+    /**
+     * function with shortened error results
+     */
+    (): Error
+  }
+  interface Func16 {
+    /**
+     * function with TS reserved param names
+     */
+    (_arg00: boolean, _arg10: string, _arg20: number, ..._arg30: any[]): void
+  }
+  interface Func17 {
+    /**
+     * function with named interface variadic params
+     */
+    (...errs: Error[]): void
+  }
+  interface Func18 {
+    /**
+     * function with qualified interface variadic params
+     */
+    (...readers: io.Reader[]): void
+  }
+  interface Func19 {
+    /**
+     * function with named results
+     */
+    (a: number, b: number): [number, number]
+  }
+  interface Func20 {
+    /**
+     * function with named result and error
+     */
+    (): number
+  }
+  interface Func21 {
+    /**
+     * function with named results of the params types (Go disallows results with the params names)
+     */
+    (a: number, b: string): [string, number]
+  }
+  interface Func22 {
+    /**
+     * function with long params list
+     */
+    (firstArgument: string, secondArgument: number, thirdArgument: _TygojaDict, fourthArgument: Array<string>, ...rest: boolean[]): void
+  }
+  interface Func23 {
+    /**
+     * function with related declarations
+     * @see {@link Func1}
+     * @see {@link Func2}
+     * @see {@link Ignored.Method}
+     */
+    (): void
+  }
+  interface Func24 {
+    /**
+     * function with empty interface variadic params
+     */
+    (format: string, ...args: any[]): void
+  }
+  /**
+   * struct with qualified generic fields from another package
+   */
+  interface Container {
+    Repos: Array<a.Repo<a.ID>>
+    Pair?: a.Pair<string, a.StructC<string, number, boolean>, any>
+  }
+  /**
+   * struct with nil-able map and slice fields
+   */
+  interface Collections {
+    Map: _TygojaDict
+    MapPtr?: _TygojaDict
+    Slice: Array<number>
+    SlicePtr?: Array<number>
+  }
+  /**
+   * struct embedding a pointer to a struct from another package
+   */
+  type _sKQhLDc = a.StructB<number>
+  interface Layered extends _sKQhLDc {
+    Name: string
+  }
+  /**
+   * generic struct embedding a pointer to a generic struct from another package
+   */
+  type _scFWrwC<T> = a.Repo<T>
+  interface LayeredOf<T> extends _scFWrwC<T> {
+    Name: string
+  }
+  /**
+   * struct with fields type overrides
+   */
+  interface Overrides {
+    /**
+     * custom marshaled field
+     */
+    Custom: string
+    Optional?: number | undefined
+  }
+  /**
+   * struct with sometimes present fields
+   */
+  interface Model {
+    Id: string
+    /**
+     * the relations are loaded only on demand
+     */
+    Expand?: _TygojaDict
+  }
+  /**
+   * struct with ignored field and method
+   */
+  interface Ignored {
+    Visible: string
+  }
+  interface Ignored {
+    /**
+     * Visible method
+     */
+    Method(): void
+  }
+  /**
+   * interface with ignored method
+   */
+  interface IgnoredInterface {
+    [key:string]: any;
+    Method(): void
+  }
+  /**
+   * struct with duration fields (see Config.DurationType)
+   */
+  interface Job {
+    Timeout: time.Duration
+    Retry?: time.Duration
+  }
+  interface Job {
+    /**
+     * Wait method with duration params
+     */
+    Wait(d: time.Duration, ...extra: time.Duration[]): time.Duration
+  }
+  /**
+   * struct with pointer elements
+   */
+  interface Registry {
+    List: Array<(Model | undefined)>
+    Map: _TygojaDict
+  }
+  interface Registry {
+    /**
+     * Add method with pointer variadic params
+     */
+    Add(...models: (Model | undefined)[]): void
+  }
+  /**
+   * struct with multiple pointer levels
+   */
+  interface Pointers {
+    Double?: Model
+    SlicePtr?: Array<number>
+  }
+  interface Pointers {
+    /**
+     * Set method with multiple pointer levels params
+     */
+    Set(double: Model, slicePtr: Array<number>): (Model)
+  }
+}
+
+namespace c {
+  /**
+   * func type comment
+   */
+  type Handler = () => string // after
+  /**
+   * Example:
+   * 
+   * ```
+   * 	Some
+   * 	code
+   * 	sample
+   * ```
+   */
+  interface Example2 {
+    Title: string
+    Json: Raw
+    Bytes: string|Array<number> // should be union
+  }
+  interface Example2 {
+    DemoEx2(): time.Time
+  }
+  interface Example2 {
+    /**
+     * Pointer as argument vs return type
+     */
+    DemoEx3(arg: Example1): (Example1)
+  }
+  interface Example2 {
+    /**
+     * ommited types
+     */
+    DemoEx4(n1: string, n2: string, n3: string): void
+  }
+  interface Example2 {
+    /**
+     * ommited names
+     */
+    DemoEx5(_arg0: string, _arg1: number): void
+  }
+  interface Example2 {
+    /**
+     * named return values
+     */
+    DemoEx6(): [number, string]
+  }
+  interface Example2 {
+    /**
+     * shortened return values
+     */
+    DemoEx7(): [string, string]
+  }
+  interface Example2 {
+    /**
+     * named and shortened return values
+     */
+    DemoEx8(): [number, string, string]
+  }
 }
 
 /**
@@ -364,6 +648,30 @@ namespace time {
      */
     AppendFormat(b: string|Array<number>, layout: string): string|Array<number>
   }
+  /**
+   * A Ticker holds a channel that delivers “ticks” of a clock
+   * at intervals.
+   */
+  interface Ticker {
+    C: undefined // The channel on which the ticks are delivered.
+  }
+  interface Ticker {
+    /**
+     * Stop turns off a ticker. After Stop, no more ticks will be sent.
+     * Stop does not close the channel, to permit calling [Ticker.Reset],
+     * and to prevent a concurrent goroutine reading from the channel
+     * from seeing an erroneous "tick".
+     */
+    Stop(): void
+  }
+  interface Ticker {
+    /**
+     * Reset stops a ticker and resets its period to the specified duration.
+     * The next tick will arrive after the new period elapses. The duration d
+     * must be greater than zero; if not, Reset will panic.
+     */
+    Reset(d: Duration): void
+  }
   /**
    * A Time represents an instant in time with nanosecond precision.
    * 
@@ -745,6 +1053,56 @@ namespace c {
   }
 }
 
+/**
+ * Package io provides basic interfaces to I/O primitives.
+ * Its primary job is to wrap existing implementations of such primitives,
+ * such as those in package os, into shared public interfaces that
+ * abstract the functionality, plus some other related primitives.
+ * 
+ * Because these interfaces and primitives wrap lower-level operations with
+ * various implementations, unless otherwise informed clients should not
+ * assume they are safe for parallel execution.
+ */
+namespace io {
+  /**
+   * Reader is the interface that wraps the basic Read method.
+   * 
+   * Read reads up to len(p) bytes into p. It returns the number of bytes
+   * read (0 <= n <= len(p)) and any error encountered. Even if Read
+   * returns n < len(p), it may use all of p as scratch space during the call.
+   * If some data is available but not len(p) bytes, Read conventionally
+   * returns what is available instead of waiting for more.
+   * 
+   * When Read encounters an error or end-of-file condition after
+   * successfully reading n > 0 bytes, it returns the number of
+   * bytes read. It may return the (non-nil) error from the same call
+   * or return the error (and n == 0) from a subsequent call.
+   * An instance of this general case is that a Reader returning
+   * a non-zero number of bytes at the end of the input stream may
+   * return either err == EOF or err == nil. The next Read should
+   * return 0, EOF.
+   * 
+   * Callers should always process the n > 0 bytes returned before
+   * considering the error err. Doing so correctly handles I/O errors
+   * that happen after reading some bytes and also both of the
+   * allowed EOF behaviors.
+   * 
+   * If len(p) == 0, Read should always return n == 0. It may return a
+   * non-nil error if some error condition is known, such as EOF.
+   * 
+   * Implementations of Read are discouraged from returning a
+   * zero byte count with a nil error, except when len(p) == 0.
+   * Callers should treat a return of 0 and nil as indicating that
+   * nothing happened; in particular it does not indicate EOF.
+   * 
+   * Implementations must not retain p.
+   */
+  interface Reader {
+    [key:string]: any;
+    Read(p: string|Array<number>): number
+  }
+}
+
 /**
  * Package time provides functionality for measuring and displaying time.
  * 
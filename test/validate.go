@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hanzoai/tygojaPB"
+)
+
+// validateFixtures lists the invalid configs and their expected Config.Validate error.
+var validateFixtures = []struct {
+	name        string
+	config      tygojaPB.Config
+	expectedErr string
+}{
+	{
+		name:        "empty config",
+		config:      tygojaPB.Config{},
+		expectedErr: "at least one package or root must be specified",
+	},
+	{
+		name: "ModuleOutput with NamespacePerPackage",
+		config: tygojaPB.Config{
+			Packages:            map[string][]string{fixturesPkg: {"*"}},
+			ModuleOutput:        true,
+			NamespacePerPackage: true,
+		},
+		expectedErr: "ModuleOutput and NamespacePerPackage cannot be used together",
+	},
+	{
+		name: "ModuleOutput with conflicting DeclarationMode",
+		config: tygojaPB.Config{
+			Packages:        map[string][]string{fixturesPkg: {"*"}},
+			ModuleOutput:    true,
+			DeclarationMode: tygojaPB.DeclarationModeNamespace,
+		},
+		expectedErr: `ModuleOutput conflicts with the "namespace" DeclarationMode`,
+	},
+	{
+		name: "ModuleName without module DeclarationMode",
+		config: tygojaPB.Config{
+			Packages:   map[string][]string{fixturesPkg: {"*"}},
+			ModuleName: "example",
+		},
+		expectedErr: `ModuleName can be used only with the "module" DeclarationMode`,
+	},
+	{
+		name: "invalid ChannelType",
+		config: tygojaPB.Config{
+			Packages:    map[string][]string{fixturesPkg: {"*"}},
+			ChannelType: "ReadableStream<T>",
+		},
+		expectedErr: `invalid ChannelType "ReadableStream<T>"`,
+	},
+	{
+		name: "invalid Globals name",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"*"}},
+			Globals:  map[string]string{"$my-app": "any"},
+		},
+		expectedErr: `invalid global variable name "$my-app"`,
+	},
+	{
+		name: "reserved Globals name",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"*"}},
+			Globals:  map[string]string{"delete": "any"},
+		},
+		expectedErr: `invalid global variable name "delete"`,
+	},
+	{
+		name: "missing Globals type",
+		config: tygojaPB.Config{
+			Packages: map[string][]string{fixturesPkg: {"*"}},
+			Globals:  map[string]string{"$app": " "},
+		},
+		expectedErr: `missing global variable "$app" type`,
+	},
+}
+
+// checkValidateFixtures checks that the invalid configs are
+// rejected with the expected error by both Config.Validate and Generate.
+func checkValidateFixtures() error {
+	for _, f := range validateFixtures {
+		err := f.config.Validate()
+		if err == nil || !strings.HasPrefix(err.Error(), f.expectedErr) {
+			return fmt.Errorf("[%s] expected Validate error %q, got %v", f.name, f.expectedErr, err)
+		}
+
+		_, err = tygojaPB.New(f.config).Generate()
+		if err == nil || !strings.Contains(err.Error(), f.expectedErr) {
+			return fmt.Errorf("[%s] expected Generate error %q, got %v", f.name, f.expectedErr, err)
+		}
+	}
+
+	valid := tygojaPB.Config{Roots: []string{fixturesPkg + ".Order"}}
+	if err := valid.Validate(); err != nil {
+		return fmt.Errorf("expected the Roots-only config to be valid, got %w", err)
+	}
+
+	return nil
+}
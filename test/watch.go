@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hanzoai/tygojaPB"
+)
+
+// watchDir is the temporary package directory used to check the Watch regeneration.
+const watchDir = "./watched"
+
+const watchSource = `package watched
+
+// Status of a task
+type Status string
+
+// Task is edited while watching
+type Task struct {
+	Title string
+}
+`
+
+// generateWatchFixture starts watching a temporary package, edits its
+// source after the initial generation and writes both results in
+// ./options/watch_{initial,changed}.d.ts.
+//
+// It also checks that the regenerated result matches a full Generate of the edited package.
+func generateWatchFixture() error {
+	if err := os.RemoveAll(watchDir); err != nil {
+		return err
+	}
+	defer os.RemoveAll(watchDir)
+
+	if err := os.Mkdir(watchDir, 0755); err != nil {
+		return err
+	}
+
+	sourceFile := filepath.Join(watchDir, "watched.go")
+	edited := strings.Replace(watchSource, "Title string", "Title  string\n\tStatus Status", 1)
+
+	if err := os.WriteFile(sourceFile, []byte(watchSource), 0644); err != nil {
+		return err
+	}
+
+	config := tygojaPB.Config{
+		Packages: map[string][]string{"github.com/hanzoai/tygojaPB/test/watched": {"Task"}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var results []string
+	var watchErr error
+
+	err := tygojaPB.New(config).Watch(ctx, func(result string, err error) {
+		if err != nil {
+			watchErr = err
+			cancel()
+			return
+		}
+
+		results = append(results, result)
+
+		if len(results) == 1 {
+			watchErr = os.WriteFile(sourceFile, []byte(edited), 0644)
+		} else {
+			cancel()
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if watchErr != nil {
+		return watchErr
+	}
+
+	if len(results) != 2 {
+		return errors.New("the watched package change wasn't regenerated")
+	}
+
+	full, err := tygojaPB.New(config).Generate()
+	if err != nil {
+		return err
+	}
+
+	if results[1] == results[0] || results[1] != full {
+		return errors.New("the watch regeneration doesn't match the full generation after the source change")
+	}
+
+	if err := writeOptionFixture("watch_initial.d.ts", results[0]); err != nil {
+		return err
+	}
+
+	return writeOptionFixture("watch_changed.d.ts", results[1])
+}
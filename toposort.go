@@ -0,0 +1,181 @@
+package tygojaPB
+
+import (
+	"go/ast"
+	"sort"
+	"strings"
+)
+
+// generatedDecl holds the generated code of a single top level declaration.
+type generatedDecl struct {
+	decl ast.Decl
+	code string
+	refs map[string]struct{} // the referenced type IDs (see addGraphEdge)
+}
+
+// generateDecl generates the code of the specified top level declaration.
+func (g *PackageGenerator) generateDecl(decl ast.Decl, write func(s *strings.Builder)) *generatedDecl {
+	sb := new(strings.Builder)
+
+	refs := map[string]struct{}{}
+
+	prev := g.references
+	g.references = refs
+	g.writeDecl(sb, decl, write)
+	g.references = prev
+
+	return &generatedDecl{decl: decl, code: sb.String(), refs: refs}
+}
+
+// topoSortDecls orders the specified declarations so that the
+// referenced package types are declared before their dependents
+// (see Config.TopoSort).
+//
+// The dependencies are resolved from the declarations type references
+// collected while writing them (the same ones used for the DeclarationGraph edges).
+//
+// The original declarations order is preserved where possible and
+// the cyclic declarations are kept together in their source order.
+func topoSortDecls(pkgID string, decls []*generatedDecl) []*generatedDecl {
+	// map each declared type ID to its declaration index
+	definedBy := map[string]int{}
+	for i, d := range decls {
+		for _, name := range declaredTypeNames(d.decl) {
+			if _, ok := definedBy[pkgID+"."+name]; !ok {
+				definedBy[pkgID+"."+name] = i
+			}
+		}
+	}
+
+	// collect the declarations dependencies
+	deps := make([]map[int]struct{}, len(decls))
+	for i, d := range decls {
+		deps[i] = map[int]struct{}{}
+
+		for ref := range d.refs {
+			if j, ok := definedBy[ref]; ok && j != i {
+				deps[i][j] = struct{}{}
+			}
+		}
+	}
+
+	// group the cyclic declarations (they are kept in their source order)
+	components := stronglyConnected(deps)
+
+	componentOf := make([]int, len(decls))
+	for c, members := range components {
+		for _, i := range members {
+			componentOf[i] = c
+		}
+	}
+
+	result := make([]*generatedDecl, 0, len(decls))
+	emitted := make([]bool, len(components))
+
+	for len(result) < len(decls) {
+		// pick the ready component with the earliest source declaration
+		next := -1
+		for c, members := range components {
+			if emitted[c] || (next != -1 && components[next][0] < members[0]) {
+				continue
+			}
+
+			ready := true
+			for _, i := range members {
+				for j := range deps[i] {
+					if dc := componentOf[j]; dc != c && !emitted[dc] {
+						ready = false
+					}
+				}
+			}
+
+			if ready {
+				next = c
+			}
+		}
+
+		emitted[next] = true
+		for _, i := range components[next] {
+			result = append(result, decls[i])
+		}
+	}
+
+	return result
+}
+
+// stronglyConnected returns the strongly connected components
+// of the specified dependency graph (using the Tarjan's algorithm).
+//
+// The members of each component are sorted by their index.
+func stronglyConnected(deps []map[int]struct{}) [][]int {
+	var (
+		index      int
+		stack      []int
+		components [][]int
+	)
+
+	indices := make([]int, len(deps))
+	lowlinks := make([]int, len(deps))
+	onStack := make([]bool, len(deps))
+	for i := range indices {
+		indices[i] = -1
+	}
+
+	var connect func(v int)
+	connect = func(v int) {
+		indices[v] = index
+		lowlinks[v] = index
+		index++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for w := range deps[v] {
+			if indices[w] == -1 {
+				connect(w)
+				lowlinks[v] = min(lowlinks[v], lowlinks[w])
+			} else if onStack[w] {
+				lowlinks[v] = min(lowlinks[v], indices[w])
+			}
+		}
+
+		if lowlinks[v] == indices[v] {
+			var component []int
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == v {
+					break
+				}
+			}
+			sort.Ints(component)
+			components = append(components, component)
+		}
+	}
+
+	for v := range deps {
+		if indices[v] == -1 {
+			connect(v)
+		}
+	}
+
+	return components
+}
+
+// declaredTypeNames returns the type names declared by the specified top level declaration.
+func declaredTypeNames(decl ast.Decl) []string {
+	gen, ok := decl.(*ast.GenDecl)
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	for _, spec := range gen.Specs {
+		if ts, ok := spec.(*ast.TypeSpec); ok {
+			names = append(names, ts.Name.Name)
+		}
+	}
+
+	return names
+}
@@ -2,8 +2,12 @@ package tygojaPB
 
 import (
 	"fmt"
+	"go/parser"
+	"io"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"golang.org/x/tools/go/packages"
@@ -17,6 +21,10 @@ type Tygoja struct {
 	parent           *Tygoja
 	implicitPackages map[string][]string
 	generatedTypes   map[string][]string
+	warnings         []Warning
+
+	incremental *incrementalState // non-nil during GenerateIncremental
+	graph       *graphState       // non-nil during DeclarationGraph
 }
 
 // New initializes a new Tygoja generator from the specified config.
@@ -30,57 +38,129 @@ func New(config Config) *Tygoja {
 	}
 }
 
+// generatedPackage holds the generated typings of a single package namespace.
+type generatedPackage struct {
+	id        string // the package import path
+	namespace string // the package TS namespace
+	code      string
+}
+
 // Generate executes the generator and produces the related TS files.
 func (g *Tygoja) Generate() (string, error) {
+	var s strings.Builder
+
+	if err := g.GenerateTo(&s); err != nil {
+		return "", err
+	}
+
+	return s.String(), nil
+}
+
+// GenerateTo executes the generator and streams the generated
+// declarations to w as soon as each package is processed.
+func (g *Tygoja) GenerateTo(w io.Writer) error {
+	if err := g.conf.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	g.reset()
+
+	hasModuleWrapper := g.conf.DeclarationMode == DeclarationModeModule && g.conf.ModuleName != ""
+
+	var s strings.Builder
+
+	if err := g.writeHeading(&s); err != nil {
+		return err
+	}
+
+	if hasModuleWrapper {
+		s.WriteString("declare module ")
+		s.WriteString(strconv.Quote(g.conf.ModuleName))
+		s.WriteString(" {\n")
+	}
+
+	g.writeBaseTypes(&s)
+
+	if _, err := io.WriteString(w, s.String()); err != nil {
+		return err
+	}
+
+	err := g.generatePackages(func(pkg generatedPackage) error {
+		_, err := io.WriteString(w, pkg.code)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if hasModuleWrapper {
+		if _, err := io.WriteString(w, "}\n"); err != nil {
+			return err
+		}
+	}
+
+	// the module mode declarations are already either exported or wrapped
+	if g.conf.ModuleGuard && g.conf.DeclarationMode != DeclarationModeModule {
+		if _, err := io.WriteString(w, "\nexport {};\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reset clears the generation state from a previous run
+// so that the same generator instance could be executed multiple times.
+func (g *Tygoja) reset() {
+	g.implicitPackages = map[string][]string{}
+	g.generatedTypes = map[string][]string{}
+	g.warnings = nil
+}
+
+// generatePackages generates the typings of the configured packages
+// followed by the typings of their recursively found unknown types.
+//
+// The emit callback is invoked in order for each generated package.
+func (g *Tygoja) generatePackages(emit func(pkg generatedPackage) error) error {
+	packageTypes, err := g.packageTypes()
+	if err != nil {
+		return err
+	}
+
 	// extract config packages
-	configPackages := make([]string, 0, len(g.conf.Packages))
-	for p, types := range g.conf.Packages {
+	configPackages := make([]string, 0, len(packageTypes))
+	for p, types := range packageTypes {
 		if len(types) == 0 {
 			continue // no typings
 		}
 		configPackages = append(configPackages, p)
 	}
+	sort.Strings(configPackages)
 
 	// load packages info
 	pkgs, err := packages.Load(&packages.Config{
 		Mode: packages.NeedSyntax | packages.NeedFiles | packages.NeedDeps | packages.NeedImports | packages.NeedTypes,
 	}, configPackages...)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	var s strings.Builder
-
-	// Heading
-	if g.parent == nil {
-		s.WriteString("// GENERATED CODE - DO NOT MODIFY BY HAND\n")
-
-		if g.conf.Heading != "" {
-			s.WriteString(g.conf.Heading)
-		}
-
-		// write base types
-		// ---
-		s.WriteString("type ")
-		s.WriteString(BaseTypeDict)
-		s.WriteString(" = { [key:string | number | symbol]: any; }\n")
-
-		s.WriteString("type ")
-		s.WriteString(BaseTypeAny)
-		s.WriteString(" = any\n")
-		// ---
-	}
+	// keep the packages (and the merged same-named declarations)
+	// order stable regardless of the loader results order
+	sort.SliceStable(pkgs, func(i, j int) bool {
+		return pkgs[i].PkgPath < pkgs[j].PkgPath
+	})
 
 	for i, pkg := range pkgs {
 		if len(pkg.Errors) > 0 {
-			return "", fmt.Errorf("%+v", pkg.Errors)
+			return fmt.Errorf("%+v", pkg.Errors)
 		}
 
 		if len(pkg.GoFiles) == 0 {
-			return "", fmt.Errorf("no input go files for package index %d", i)
+			return fmt.Errorf("no input go files for package index %d", i)
 		}
 
-		if len(g.conf.Packages[pkg.ID]) == 0 {
+		if len(packageTypes[pkg.ID]) == 0 {
 			// ignore the package as it has no typings
 			continue
 		}
@@ -88,69 +168,42 @@ func (g *Tygoja) Generate() (string, error) {
 		pkgGen := &PackageGenerator{
 			conf:           g.conf,
 			pkg:            pkg,
-			types:          g.conf.Packages[pkg.ID],
+			types:          packageTypes[pkg.ID],
 			generatedTypes: map[string]struct{}{},
 			unknownTypes:   map[string]struct{}{},
 			imports:        map[string][]string{},
+			incremental:    g.incremental,
+			graph:          g.graph,
 		}
 
 		code, err := pkgGen.Generate()
 		if err != nil {
-			return "", err
+			return err
 		}
 
+		g.addWarnings(pkgGen.warnings...)
+
 		for t := range pkgGen.generatedTypes {
 			g.generatedTypes[pkg.ID] = append(g.generatedTypes[pkg.ID], t)
 		}
 
-		for t := range pkgGen.unknownTypes {
-			parts := strings.Split(t, ".")
-			var tPkg string
-			var tName string
-
-			if len(parts) == 0 {
-				continue
-			}
-
-			if len(parts) == 2 {
-				// type from external package
-				tPkg = parts[0]
-				tName = parts[1]
-			} else {
-				// unexported type from the current package
-				tName = parts[0]
+		g.registerUnknownTypes(pkgGen)
 
-				// already mapped for export
-				if pkgGen.isTypeAllowed(tName) {
-					continue
-				}
-
-				tPkg = packageNameFromPath(pkg.ID)
-
-				// add to self import later
-				pkgGen.imports[pkg.ID] = []string{tPkg}
-			}
-
-			for p, aliases := range pkgGen.imports {
-				for _, alias := range aliases {
-					if tName != "" && alias == tPkg && !g.isGenerated(p, tName) && !exists(g.implicitPackages[p], tName) {
-						if g.implicitPackages[p] == nil {
-							g.implicitPackages[p] = []string{}
-						}
-						g.implicitPackages[p] = append(g.implicitPackages[p], tName)
-						break
-					}
-				}
-			}
+		err = emit(generatedPackage{
+			id:        pkg.ID,
+			namespace: packageNameFromPath(pkg.ID),
+			code:      code,
+		})
+		if err != nil {
+			return err
 		}
-
-		s.WriteString(code)
 	}
 
 	// recursively try to generate the found unknown types
 	if len(g.implicitPackages) > 0 {
 		subConfig := *g.conf
 		subConfig.Heading = ""
+		subConfig.Roots = nil
 		if (subConfig.TypeMappings) == nil {
 			subConfig.TypeMappings = map[string]string{}
 		}
@@ -158,23 +211,323 @@ func (g *Tygoja) Generate() (string, error) {
 		// extract the nonempty package definitions
 		subConfig.Packages = make(map[string][]string, len(g.implicitPackages))
 		for p, types := range g.implicitPackages {
-			if len(types) == 0 {
+			// skip the types that were registered before their package
+			// generation (eg. from the Config.Globals)
+			var pending []string
+			for _, t := range types {
+				if !g.isGenerated(p, t) {
+					pending = append(pending, t)
+				}
+			}
+			if len(pending) == 0 {
 				continue
 			}
-			subConfig.Packages[p] = types
+			sort.Strings(pending)
+			subConfig.Packages[p] = pending
+		}
+
+		if len(subConfig.Packages) == 0 {
+			return nil // all implicit types were already generated
 		}
 
 		subGenerator := New(subConfig)
 		subGenerator.parent = g
-		subResult, err := subGenerator.Generate()
+		subGenerator.incremental = g.incremental
+		subGenerator.graph = g.graph
+		if err := subGenerator.generatePackages(emit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registerUnknownTypes registers the unknown types of the specified
+// package generator for the implicit packages generation.
+func (g *Tygoja) registerUnknownTypes(pkgGen *PackageGenerator) {
+	for t := range pkgGen.unknownTypes {
+		parts := strings.Split(t, ".")
+		var tPkg string
+		var tName string
+
+		if len(parts) == 0 {
+			continue
+		}
+
+		if len(parts) == 2 {
+			// type from external package
+			tPkg = parts[0]
+			tName = parts[1]
+		} else {
+			// unexported type from the current package
+			tName = parts[0]
+
+			// already mapped for export or without package (eg. from the Config.Globals)
+			if pkgGen.isTypeAllowed(tName) || pkgGen.pkg.ID == "" {
+				continue
+			}
+
+			tPkg = packageNameFromPath(pkgGen.pkg.ID)
+
+			// add to self import later
+			pkgGen.imports[pkgGen.pkg.ID] = []string{tPkg}
+		}
+
+		for p, aliases := range pkgGen.imports {
+			for _, alias := range aliases {
+				if tName != "" && alias == tPkg && !g.isGenerated(p, tName) && !exists(g.implicitPackages[p], tName) {
+					if g.implicitPackages[p] == nil {
+						g.implicitPackages[p] = []string{}
+					}
+					g.implicitPackages[p] = append(g.implicitPackages[p], tName)
+					break
+				}
+			}
+		}
+	}
+}
+
+// packageTypes returns the types to generate for each package.
+//
+// If Config.Roots is set, the returned types are the ones reachable
+// from the roots in their DeclarationGraph.
+func (g *Tygoja) packageTypes() (map[string][]string, error) {
+	if len(g.conf.Roots) == 0 {
+		return g.conf.Packages, nil
+	}
+
+	rootsConfig := *g.conf
+	rootsConfig.Roots = nil
+	rootsConfig.Packages = map[string][]string{}
+	for _, root := range g.conf.Roots {
+		idx := strings.LastIndex(root, ".")
+		rootsConfig.Packages[root[:idx]] = append(rootsConfig.Packages[root[:idx]], root[idx+1:])
+	}
+
+	graph, err := New(rootsConfig).DeclarationGraph()
+	if err != nil {
+		return nil, err
+	}
+
+	return graph.reachableTypes(g.conf.Roots), nil
+}
+
+// writeHeading writes the generated output heading, including
+// the Config.Heading and Config.Globals declarations.
+func (g *Tygoja) writeHeading(s *strings.Builder) error {
+	s.WriteString("// GENERATED CODE - DO NOT MODIFY BY HAND\n")
+
+	if g.conf.Heading != "" {
+		s.WriteString(g.conf.Heading)
+	}
+
+	return g.writeGlobals(s)
+}
+
+// writeBaseTypes writes the custom base types that every package has access to.
+func (g *Tygoja) writeBaseTypes(s *strings.Builder) {
+	g.writeBaseTypeStart(s)
+	s.WriteString(BaseTypeDict)
+	s.WriteString(" = { [key:string | number | symbol]: any; }\n")
+
+	g.writeBaseTypeStart(s)
+	s.WriteString(BaseTypeAny)
+	s.WriteString(" = any\n")
+
+	if g.hasDurationBaseType() {
+		g.writeBaseTypeStart(s)
+		s.WriteString(BaseTypeDuration)
+		s.WriteString(" = ")
+		s.WriteString(g.conf.NumberType)
+		s.WriteString(" & { readonly __brand: \"Duration\" }\n")
+	}
+
+	if g.conf.DistinguishIntFloat {
+		for _, t := range goNumericTypes {
+			g.writeBaseTypeStart(s)
+			s.WriteString(t)
+			if g.conf.Int64AsBigInt && isGo64BitInt(t) {
+				s.WriteString(" = bigint\n")
+			} else if isGoComplex(t) {
+				s.WriteString(" = ")
+				s.WriteString(g.conf.ComplexType)
+				s.WriteString("\n")
+			} else {
+				s.WriteString(" = ")
+				s.WriteString(g.conf.NumberType)
+				s.WriteString("\n")
+			}
+		}
+	}
+}
+
+// hasDurationBaseType checks whether the time.Duration is written
+// as the branded BaseTypeDuration (aka. it is not overwritten
+// by an explicit TypeMappings entry).
+func (g *Tygoja) hasDurationBaseType() bool {
+	return g.conf.DurationType == DurationTypeBranded && g.conf.TypeMappings["time.Duration"] == BaseTypeDuration
+}
+
+// baseTypeNames returns the names of the base types written by writeBaseTypes.
+func (g *Tygoja) baseTypeNames() []string {
+	names := []string{BaseTypeDict, BaseTypeAny}
+
+	if g.hasDurationBaseType() {
+		names = append(names, BaseTypeDuration)
+	}
+
+	if g.conf.DistinguishIntFloat {
+		names = append(names, goNumericTypes...)
+	}
+
+	return names
+}
+
+// writeBaseTypeStart writes the start of a base type alias declaration.
+func (g *Tygoja) writeBaseTypeStart(s *strings.Builder) {
+	if g.conf.DeclarationMode == DeclarationModeModule {
+		s.WriteString("export ")
+	}
+	s.WriteString("type ")
+}
+
+// writeGlobals writes the configured Config.Globals as "declare var" statements.
+//
+// The referenced package types are registered for the implicit packages
+// generation so it must be called before generatePackages.
+func (g *Tygoja) writeGlobals(s *strings.Builder) error {
+	if len(g.conf.Globals) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(g.conf.Globals))
+	for name := range g.conf.Globals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// package independent generator used only for resolving the global types
+	pkgGen := &PackageGenerator{
+		conf:           g.conf,
+		pkg:            &packages.Package{},
+		generatedTypes: map[string]struct{}{},
+		unknownTypes:   map[string]struct{}{},
+		imports:        map[string][]string{},
+	}
+
+	for _, name := range names {
+		expr, err := parser.ParseExpr(g.conf.Globals[name])
 		if err != nil {
-			return "", err
+			return fmt.Errorf("invalid global %q type: %w", name, err)
 		}
 
-		s.WriteString(subResult)
+		s.WriteString("declare var ")
+		s.WriteString(name)
+		s.WriteString(": ")
+		pkgGen.writeType(s, expr, 0, optionFunctionReturn)
+		s.WriteString("\n")
 	}
 
-	return s.String(), nil
+	g.addWarnings(pkgGen.warnings...)
+
+	if len(pkgGen.unknownTypes) == 0 {
+		return nil
+	}
+
+	paths, err := g.globalsPackagePaths(pkgGen.unknownTypes)
+	if err != nil {
+		return err
+	}
+
+	for name, path := range paths {
+		pkgGen.imports[path] = []string{name}
+	}
+
+	g.registerUnknownTypes(pkgGen)
+
+	return nil
+}
+
+// globalsPackagePaths resolves the package names of the specified
+// qualified global types (eg. "core" for "core.App") to their import paths.
+//
+// The package names are resolved against the configured packages and their
+// imports, and as a fallback against the package with the same path (eg. "time").
+// The unresolved package names are omitted from the result.
+func (g *Tygoja) globalsPackagePaths(unknownTypes map[string]struct{}) (map[string]string, error) {
+	pending := map[string]struct{}{}
+	for t := range unknownTypes {
+		if name, _, ok := strings.Cut(t, "."); ok {
+			pending[name] = struct{}{}
+		}
+	}
+
+	result := map[string]string{}
+
+	collect := func(patterns []string, withImports bool) error {
+		if len(pending) == 0 || len(patterns) == 0 {
+			return nil
+		}
+
+		pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedImports}, patterns...)
+		if err != nil {
+			return err
+		}
+
+		for _, pkg := range pkgs {
+			candidates := []*packages.Package{pkg}
+			if withImports {
+				for _, imp := range pkg.Imports {
+					candidates = append(candidates, imp)
+				}
+			}
+
+			for _, c := range candidates {
+				if len(c.Errors) > 0 || c.Name == "" {
+					continue
+				}
+				if _, ok := pending[c.Name]; ok {
+					result[c.Name] = c.PkgPath
+					delete(pending, c.Name)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	// the roots packages are also considered in case of Roots-only config
+	uniquePackages := make(map[string]struct{}, len(g.conf.Packages)+len(g.conf.Roots))
+	for p := range g.conf.Packages {
+		uniquePackages[p] = struct{}{}
+	}
+	for _, root := range g.conf.Roots {
+		if idx := strings.LastIndex(root, "."); idx > 0 {
+			uniquePackages[root[:idx]] = struct{}{}
+		}
+	}
+
+	configPackages := make([]string, 0, len(uniquePackages))
+	for p := range uniquePackages {
+		configPackages = append(configPackages, p)
+	}
+	sort.Strings(configPackages)
+
+	if err := collect(configPackages, true); err != nil {
+		return nil, err
+	}
+
+	fallbacks := make([]string, 0, len(pending))
+	for name := range pending {
+		fallbacks = append(fallbacks, name)
+	}
+	sort.Strings(fallbacks)
+
+	if err := collect(fallbacks, false); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 func (g *PackageGenerator) markAsGenerated(t string) {
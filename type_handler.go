@@ -0,0 +1,69 @@
+package tygojaPB
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// TypeHandlerFunc defines a function for custom handling of the
+// Go type expressions (see Config.TypeHandler).
+//
+// Returning ok=true writes the returned TS type as it is and
+// skips the builtin handling of the expression.
+type TypeHandlerFunc func(t ast.Expr, ctx HandlerContext) (tsType string, ok bool)
+
+// HandlerContext describes the context of a TypeHandlerFunc call.
+type HandlerContext struct {
+	// Package is the import path of the package with the handled expression.
+	Package string
+
+	// Position is the source position of the handled expression.
+	Position token.Position
+
+	// Depth is the current indentation level.
+	Depth int
+
+	// WriteType returns the generated TS type of the specified expression.
+	//
+	// Sub expressions are also passed to the TypeHandler, while the
+	// handled expression itself is written using the builtin handling.
+	WriteType func(t ast.Expr) string
+}
+
+// handleType invokes the Config.TypeHandler (if any) for the specified expression.
+func (g *PackageGenerator) handleType(s *strings.Builder, t ast.Expr, depth int, options ...string) bool {
+	if g.conf.TypeHandler == nil || t == nil || t == g.handledType {
+		return false
+	}
+
+	ctx := HandlerContext{
+		Depth: depth,
+		WriteType: func(expr ast.Expr) string {
+			prev := g.handledType
+			g.handledType = t
+			defer func() {
+				g.handledType = prev
+			}()
+
+			sb := new(strings.Builder)
+			g.writeType(sb, expr, depth, options...)
+			return sb.String()
+		},
+	}
+
+	if g.pkg != nil {
+		ctx.Package = g.pkg.ID
+
+		if g.pkg.Fset != nil {
+			ctx.Position = g.pkg.Fset.Position(t.Pos())
+		}
+	}
+
+	tsType, ok := g.conf.TypeHandler(t, ctx)
+	if ok {
+		s.WriteString(tsType)
+	}
+
+	return ok
+}
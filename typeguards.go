@@ -0,0 +1,236 @@
+package tygojaPB
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// GenerateTypeGuards produces a companion ".ts" file content with a
+// runtime type guard function for each exported non-generic struct
+// of the configured packages, eg.:
+//
+//	export function isModelsUser(x: unknown): x is models.User { ... }
+//
+// The guards check the primitive, array and map fields against their
+// generated TS types. Fields of any other type (structs, interfaces,
+// funcs, etc.) and the fields with a "//tygoja:type" directive are only
+// checked for presence and the embedded struct fields are not checked.
+//
+// The guards refer to the generated namespaces so the typings must be
+// in scope (eg. with DeclarationModeModule you would have to import them).
+func (g *Tygoja) GenerateTypeGuards() (string, error) {
+	if err := g.conf.Validate(); err != nil {
+		return "", fmt.Errorf("invalid config: %w", err)
+	}
+
+	packageTypes, err := g.packageTypes()
+	if err != nil {
+		return "", err
+	}
+
+	paths := make([]string, 0, len(packageTypes))
+	for p, types := range packageTypes {
+		if len(types) == 0 {
+			continue // no typings
+		}
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedSyntax | packages.NeedFiles | packages.NeedDeps | packages.NeedImports | packages.NeedTypes,
+	}, paths...)
+	if err != nil {
+		return "", err
+	}
+
+	var s strings.Builder
+
+	s.WriteString("// GENERATED CODE - DO NOT MODIFY BY HAND\n")
+
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return "", fmt.Errorf("%+v", pkg.Errors)
+		}
+
+		pkgGen := &PackageGenerator{
+			conf:  g.conf,
+			pkg:   pkg,
+			types: packageTypes[pkg.ID],
+		}
+
+		pkgGen.writeTypeGuards(&s)
+	}
+
+	return s.String(), nil
+}
+
+// writeTypeGuards writes the type guards of the package allowed structs.
+func (g *PackageGenerator) writeTypeGuards(s *strings.Builder) {
+	namespace := packageNameFromPath(g.pkg.ID)
+
+	for _, file := range g.syntaxFiles() {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || g.isHidden(gen.Doc) {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok || !ts.Name.IsExported() || ts.TypeParams != nil || !g.isTypeAllowed(ts.Name.Name) {
+					continue
+				}
+
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok || g.isHidden(ts.Doc) {
+					continue
+				}
+
+				g.writeTypeGuard(s, namespace, ts.Name.Name, st)
+			}
+		}
+	}
+}
+
+// writeTypeGuard writes a single struct type guard function.
+func (g *PackageGenerator) writeTypeGuard(s *strings.Builder, namespace string, name string, st *ast.StructType) {
+	s.WriteString("\nexport function is")
+	s.WriteString(strings.ToUpper(namespace[:1]))
+	s.WriteString(namespace[1:])
+	s.WriteString(name)
+	s.WriteString("(x: unknown): x is ")
+	s.WriteString(namespace)
+	s.WriteString(".")
+	s.WriteString(name)
+	s.WriteString(" {\n")
+	s.WriteString("  if (typeof x !== \"object\" || x === null) {\n")
+	s.WriteString("    return false\n")
+	s.WriteString("  }\n")
+
+	checks := []string{}
+	for _, f := range g.resolveStructFields(st.Fields.List) {
+		prop := "v." + f.name
+		if !isValidJSName(f.name) {
+			prop = "v[" + jsStringLiteral(f.name) + "]"
+		}
+
+		typ, isPointer := unwrapPointers(f.field.Type)
+		check := g.typeGuardCheck(typ, prop, 0)
+		if _, ok := typeOverride(f.field.Doc); ok {
+			check = prop + " !== undefined"
+		}
+
+		if isPointer || isOptionalField(f.field.Doc) {
+			if check == prop+" !== undefined" {
+				continue // any value is allowed
+			}
+			check = "(" + prop + " === undefined || " + prop + " === null || " + check + ")"
+		}
+
+		checks = append(checks, check)
+	}
+
+	if len(checks) == 0 {
+		s.WriteString("  return true\n")
+		s.WriteString("}\n")
+		return
+	}
+
+	s.WriteString("  const v = x as Record<string, unknown>\n")
+	s.WriteString("  return (\n    ")
+	s.WriteString(strings.Join(checks, " &&\n    "))
+	s.WriteString("\n  )\n")
+	s.WriteString("}\n")
+}
+
+// typeGuardCheck returns the runtime check expression of the value v
+// against the specified field type.
+//
+// Unsupported types fallback to a loose presence check.
+func (g *PackageGenerator) typeGuardCheck(t ast.Expr, v string, depth int) string {
+	loose := v + " !== undefined"
+
+	switch t := t.(type) {
+	case *ast.Ident:
+		if _, ok := g.conf.TypeMappings[t.Name]; ok {
+			return loose
+		}
+
+		basic, ok := g.basicType(t.Name)
+		if !ok {
+			return loose
+		}
+
+		// the custom TS types (eg. NumberType "GoNumber") could
+		// have any runtime representation so only their presence is checked
+		kind := basic.Info()
+		switch {
+		case kind&types.IsString != 0:
+			if g.conf.StringType == defaultStringType {
+				return "typeof " + v + " === \"string\""
+			}
+		case kind&types.IsBoolean != 0:
+			if g.conf.BoolType == defaultBoolType {
+				return "typeof " + v + " === \"boolean\""
+			}
+		case kind&types.IsComplex != 0:
+			return loose
+		case kind&types.IsInteger != 0 && g.conf.Int64AsBigInt && isGo64BitInt(basic.Name()):
+			return "typeof " + v + " === \"bigint\""
+		case kind&types.IsNumeric != 0:
+			if g.conf.NumberType == defaultNumberType {
+				return "typeof " + v + " === \"number\""
+			}
+		}
+	case *ast.ArrayType:
+		// []byte is written as string|Array<number>
+		if ident, ok := t.Elt.(*ast.Ident); ok && (ident.Name == "byte" || ident.Name == "uint8") {
+			return "(typeof " + v + " === \"string\" || Array.isArray(" + v + "))"
+		}
+
+		elem := "e" + strconv.Itoa(depth)
+		elemCheck := g.typeGuardCheck(t.Elt, elem, depth+1)
+		if _, isPointer := t.Elt.(*ast.StarExpr); isPointer || elemCheck == elem+" !== undefined" {
+			return "Array.isArray(" + v + ")"
+		}
+
+		return "Array.isArray(" + v + ") && " + v + ".every((" + elem + ") => " + elemCheck + ")"
+	case *ast.MapType:
+		check := "typeof " + v + " === \"object\" && " + v + " !== null"
+
+		elem := "e" + strconv.Itoa(depth)
+		elemCheck := g.typeGuardCheck(t.Value, elem, depth+1)
+		if _, isPointer := t.Value.(*ast.StarExpr); isPointer || elemCheck == elem+" !== undefined" {
+			return check
+		}
+
+		return check + " && Object.values(" + v + ").every((" + elem + ") => " + elemCheck + ")"
+	}
+
+	return loose
+}
+
+// basicType returns the underlying basic type of the
+// specified builtin or package level named basic type.
+func (g *PackageGenerator) basicType(name string) (*types.Basic, bool) {
+	obj := types.Universe.Lookup(name)
+	if obj == nil && g.pkg.Types != nil {
+		obj = g.pkg.Types.Scope().Lookup(name)
+	}
+
+	tn, ok := obj.(*types.TypeName)
+	if !ok {
+		return nil, false
+	}
+
+	basic, ok := tn.Type().Underlying().(*types.Basic)
+
+	return basic, ok
+}
@@ -0,0 +1,115 @@
+package tygojaPB
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// typeSetElements returns the type set elements of a constraint interface
+// (eg. "~int | ~float64" in "interface { ~int | ~float64; String() string }").
+//
+// Embedded interfaces are not considered type set elements unless
+// they are builtin non-interface types (eg. "interface { int }").
+func typeSetElements(iface *ast.InterfaceType) []ast.Expr {
+	if iface.Methods == nil {
+		return nil
+	}
+
+	var result []ast.Expr
+
+	for _, f := range iface.Methods.List {
+		if len(f.Names) > 0 {
+			continue // method
+		}
+
+		switch t := f.Type.(type) {
+		case *ast.BinaryExpr:
+			if t.Op == token.OR {
+				result = append(result, t)
+			}
+		case *ast.UnaryExpr:
+			if t.Op == token.TILDE {
+				result = append(result, t)
+			}
+		case *ast.Ident:
+			if isGoBasicType(t.Name) {
+				result = append(result, t)
+			}
+		}
+	}
+
+	return result
+}
+
+// writeTypeSetElements writes the specified type set elements as TS union type
+// (multiple elements are intersections, eg. "(A | B) & (B | C)").
+func (g *PackageGenerator) writeTypeSetElements(s *strings.Builder, elements []ast.Expr, depth int) {
+	for i, elem := range elements {
+		if i > 0 {
+			s.WriteString(" & ")
+		}
+
+		terms := g.unionTerms(elem, depth)
+
+		if len(elements) > 1 && len(terms) > 1 {
+			s.WriteByte('(')
+		}
+
+		g.writeUnionTerms(s, terms, depth)
+
+		if len(elements) > 1 && len(terms) > 1 {
+			s.WriteByte(')')
+		}
+	}
+}
+
+// writeUnionTerms writes the specified union terms separated with "|".
+//
+// If Config.MaxLineLength is set, the terms that exceed it are wrapped on a new line.
+func (g *PackageGenerator) writeUnionTerms(s *strings.Builder, terms []string, depth int) {
+	for i, term := range terms {
+		if i > 0 {
+			if g.conf.MaxLineLength > 0 && lineLength(s)+len(term)+3 > g.conf.MaxLineLength {
+				s.WriteByte('\n')
+				g.writeIndent(s, depth+1)
+				s.WriteString("| ")
+			} else {
+				s.WriteString(" | ")
+			}
+		}
+
+		s.WriteString(term)
+	}
+}
+
+// unionTerms flattens the specified "A | B | C" type set element and
+// returns its unique resolved TS types.
+func (g *PackageGenerator) unionTerms(elem ast.Expr, depth int) []string {
+	var result []string
+
+	var collect func(e ast.Expr)
+	collect = func(e ast.Expr) {
+		if b, ok := e.(*ast.BinaryExpr); ok && b.Op == token.OR {
+			collect(b.X)
+			collect(b.Y)
+			return
+		}
+
+		sb := new(strings.Builder)
+		g.writeType(sb, e, depth, optionParenthesis)
+
+		if term := sb.String(); !exists(result, term) {
+			result = append(result, term)
+		}
+	}
+
+	collect(elem)
+
+	return result
+}
+
+// isGoBasicType checks whether name is a Go predeclared non-interface type identifier.
+func isGoBasicType(name string) bool {
+	return name == "bool" || name == "string" || exists(goNumericTypes, name)
+}
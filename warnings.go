@@ -0,0 +1,63 @@
+package tygojaPB
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// Warning describes a non-fatal issue found during the generation,
+// usually a Go construct that couldn't be represented in TS.
+type Warning struct {
+	// Package is the import path of the package with the warning construct.
+	Package string
+
+	// Position is the source position of the warning construct.
+	Position token.Position
+
+	// Construct is the Go source representation of the warning construct (eg. "&T").
+	Construct string
+
+	// Message is a short description of the warning.
+	Message string
+}
+
+// String implements the [fmt.Stringer] interface.
+func (w Warning) String() string {
+	if w.Position.IsValid() {
+		return fmt.Sprintf("%s: %s: %s", w.Position, w.Message, w.Construct)
+	}
+
+	return fmt.Sprintf("%s: %s", w.Message, w.Construct)
+}
+
+// Warnings returns the warnings collected during the last generation.
+func (g *Tygoja) Warnings() []Warning {
+	return g.warnings
+}
+
+// addWarnings appends the specified warnings to the root generator.
+func (g *Tygoja) addWarnings(warnings ...Warning) {
+	root := g
+	for root.parent != nil {
+		root = root.parent
+	}
+
+	root.warnings = append(root.warnings, warnings...)
+}
+
+// warn registers a new warning for the specified expression.
+func (g *PackageGenerator) warn(n ast.Expr, message string) {
+	w := Warning{
+		Package:   g.pkg.ID,
+		Construct: types.ExprString(n),
+		Message:   message,
+	}
+
+	if g.pkg.Fset != nil {
+		w.Position = g.pkg.Fset.Position(n.Pos())
+	}
+
+	g.warnings = append(g.warnings, w)
+}
@@ -0,0 +1,166 @@
+package tygojaPB
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	// watchInterval is the interval between 2 checks of the watched source files.
+	watchInterval = 300 * time.Millisecond
+
+	// watchDebounce is the minimum time without new source changes
+	// before triggering a regeneration.
+	watchDebounce = 200 * time.Millisecond
+)
+
+// Watch generates the typings and then regenerates them each time
+// a Go file in the configured packages source directories changes.
+//
+// onChange is invoked with the result of the initial generation and
+// after every (debounced) regeneration. The unchanged declarations
+// from the previous run are reused (see Tygoja.GenerateIncremental).
+//
+// The source files are polled so no platform file notifications are required.
+//
+// Watch blocks until ctx is canceled.
+func (g *Tygoja) Watch(ctx context.Context, onChange func(result string, err error)) error {
+	if err := g.conf.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	dirs, err := g.sourceDirs()
+	if err != nil {
+		return err
+	}
+
+	var manifest Manifest
+
+	regenerate := func() {
+		result, next, err := g.GenerateIncremental(manifest)
+		if err == nil {
+			manifest = next
+		} else {
+			// the failed run could have partially updated the state
+			manifest = Manifest{}
+		}
+		onChange(result, err)
+	}
+
+	snapshot := watchSnapshot(dirs)
+
+	regenerate()
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	var changedAt time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			current := watchSnapshot(dirs)
+			if !sameSnapshot(snapshot, current) {
+				snapshot = current
+				changedAt = now
+				continue
+			}
+
+			if !changedAt.IsZero() && now.Sub(changedAt) >= watchDebounce {
+				changedAt = time.Time{}
+				regenerate()
+			}
+		}
+	}
+}
+
+// sourceDirs returns the sorted source directories of the configured packages.
+func (g *Tygoja) sourceDirs() ([]string, error) {
+	packageTypes, err := g.packageTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(packageTypes))
+	for p := range packageTypes {
+		paths = append(paths, p)
+	}
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedFiles}, paths...)
+	if err != nil {
+		return nil, err
+	}
+
+	unique := map[string]struct{}{}
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("%+v", pkg.Errors)
+		}
+
+		for _, f := range pkg.GoFiles {
+			unique[filepath.Dir(f)] = struct{}{}
+		}
+	}
+
+	dirs := make([]string, 0, len(unique))
+	for dir := range unique {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	return dirs, nil
+}
+
+// watchSnapshot returns the modification time and size of
+// every Go file in the specified directories keyed by its path.
+//
+// Unreadable directories are skipped so that they could be
+// picked up again in a subsequent snapshot.
+func watchSnapshot(dirs []string) map[string]string {
+	result := map[string]string{}
+
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+				continue
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+
+			result[filepath.Join(dir, entry.Name())] = fmt.Sprintf("%d:%d", info.ModTime().UnixNano(), info.Size())
+		}
+	}
+
+	return result
+}
+
+func sameSnapshot(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
@@ -2,15 +2,45 @@ package tygojaPB
 
 import (
 	"go/ast"
+	"go/types"
+	"regexp"
+	"sort"
 	"strings"
 )
 
-func (g *PackageGenerator) writeCommentGroup(s *strings.Builder, f *ast.CommentGroup, depth int) {
-	if f == nil {
-		return
+// writeCommentGroup writes the specified comment group as JSDoc block.
+//
+// The optional extraLines (usually JSDoc tags like "@readonly") are
+// appended at the end of the block. If the comment group is nil (or empty)
+// and there are no extraLines, nothing is written.
+func (g *PackageGenerator) writeCommentGroup(s *strings.Builder, f *ast.CommentGroup, depth int, extraLines ...string) {
+	if g.isInternal(f) {
+		extraLines = append(extraLines, "@internal")
 	}
 
-	docLines := strings.Split(f.Text(), "\n")
+	var docLines []string
+	if f != nil {
+		// note: the directive only comment groups (eg. "//tygoja:optional-from=1") have empty text
+		if text := f.Text(); strings.TrimSpace(text) != "" {
+			docLines = strings.Split(text, "\n")
+		}
+	}
+
+	if g.conf.DeprecatedAsJSDoc {
+		var tags []string
+		docLines, tags = extractDeprecation(docLines)
+		extraLines = append(tags, extraLines...)
+	}
+
+	if g.conf.SeeAlsoAsJSDoc {
+		var tags []string
+		docLines, tags = g.extractSeeAlso(docLines)
+		extraLines = append(tags, extraLines...)
+	}
+
+	if len(docLines) == 0 && len(extraLines) == 0 {
+		return
+	}
 
 	g.writeIndent(s, depth)
 	s.WriteString("/**\n")
@@ -53,6 +83,10 @@ func (g *PackageGenerator) writeCommentGroup(s *strings.Builder, f *ast.CommentG
 
 		// write comment line
 		if !isEmpty {
+			if g.conf.LinkifyDocs && !isCodeBlock {
+				c = g.linkifyDocLine(c)
+			}
+
 			g.writeIndent(s, depth)
 			s.WriteString(" * ")
 			c = strings.ReplaceAll(c, "*/", "*\\/") // An edge case: a // comment can contain */
@@ -61,6 +95,161 @@ func (g *PackageGenerator) writeCommentGroup(s *strings.Builder, f *ast.CommentG
 		}
 	}
 
+	for _, line := range extraLines {
+		g.writeIndent(s, depth)
+		s.WriteString(" * ")
+		s.WriteString(strings.ReplaceAll(line, "*/", "*\\/"))
+		s.WriteByte('\n')
+	}
+
 	g.writeIndent(s, depth)
 	s.WriteString(" */\n")
 }
+
+// resultsJSDoc returns the "@returns" JSDoc lines describing the
+// tuple shape of a function with multiple named results
+// (see Config.NamedResultsAsJSDoc).
+//
+// eg. "func() (user *User, total int, err error)" will result in:
+//
+//	@returns [user, total]
+func (g *PackageGenerator) resultsJSDoc(t ast.Expr) []string {
+	if !g.conf.NamedResultsAsJSDoc {
+		return nil
+	}
+
+	ft, ok := t.(*ast.FuncType)
+	if !ok || ft.Results == nil {
+		return nil
+	}
+
+	results := ft.Results.List
+
+	// the last error is not part of the returned JS value
+	if len(results) > 0 {
+		if last, ok := results[len(results)-1].Type.(*ast.Ident); ok && last.Name == "error" && len(results[len(results)-1].Names) <= 1 {
+			results = results[:len(results)-1]
+		}
+	}
+
+	var names []string
+	var descriptions []string
+	for _, f := range results {
+		if len(f.Names) == 0 {
+			return nil // unnamed results
+		}
+
+		comment := g.resultComment(f)
+
+		for _, name := range f.Names {
+			names = append(names, name.Name)
+
+			if comment != nil {
+				descriptions = append(descriptions, " - "+name.Name+": "+strings.Join(strings.Fields(comment.Text()), " "))
+			}
+		}
+	}
+
+	if len(names) < 2 {
+		return nil // not a tuple
+	}
+
+	return append([]string{"@returns [" + strings.Join(names, ", ") + "]"}, descriptions...)
+}
+
+// resultComment returns the doc or trailing comment of the specified result field.
+//
+// The parser doesn't associate comments with the function params and results,
+// so they are looked up by position from the file comments.
+func (g *PackageGenerator) resultComment(f *ast.Field) *ast.CommentGroup {
+	if f.Doc != nil {
+		return f.Doc
+	}
+
+	if f.Comment != nil {
+		return f.Comment
+	}
+
+	if g.pkg == nil || g.pkg.Fset == nil {
+		return nil
+	}
+
+	fieldStart := g.pkg.Fset.Position(f.Pos())
+	fieldEnd := g.pkg.Fset.Position(f.End())
+
+	var doc *ast.CommentGroup
+
+	for _, file := range g.pkg.Syntax {
+		if f.Pos() < file.Pos() || f.End() > file.End() {
+			continue
+		}
+
+		for _, c := range file.Comments {
+			start := g.pkg.Fset.Position(c.Pos())
+			end := g.pkg.Fset.Position(c.End())
+
+			// trailing comment on the same line (eg. "total int, // comment")
+			if c.Pos() > f.End() && start.Line == fieldEnd.Line {
+				return c
+			}
+
+			// aligned comment on the line before the field
+			if c.End() < f.Pos() && end.Line == fieldStart.Line-1 && start.Column == fieldStart.Column {
+				doc = c
+			}
+		}
+	}
+
+	return doc
+}
+
+// linkifyDocLine replaces the whole word mentions of the current
+// package exported types with "{@link TypeName}" (see Config.LinkifyDocs).
+func (g *PackageGenerator) linkifyDocLine(line string) string {
+	if g.linkRegex == nil {
+		g.linkRegex = g.docLinksRegex()
+	}
+
+	if g.linkRegex.String() == "" {
+		return line // no types to link
+	}
+
+	return g.linkRegex.ReplaceAllStringFunc(line, func(match string) string {
+		// preserve the existing links, the code spans and the other packages references
+		if strings.HasPrefix(match, "{") || strings.HasPrefix(match, "`") || strings.Contains(match, ".") {
+			return match
+		}
+
+		return "{@link " + g.formatDeclName(DeclarationKindType, match) + "}"
+	})
+}
+
+// docLinksRegex builds the regex that matches the whole word
+// exported type names of the current package allowed for generation.
+//
+// The existing "{@link ...}" tags, "`...`" code spans and qualified
+// references (eg. "keys.Code") are also matched so that they could be
+// skipped from the replacement.
+func (g *PackageGenerator) docLinksRegex() *regexp.Regexp {
+	var names []string
+
+	if g.pkg != nil && g.pkg.Types != nil {
+		scope := g.pkg.Types.Scope()
+		for _, name := range scope.Names() {
+			if _, ok := scope.Lookup(name).(*types.TypeName); ok && ast.IsExported(name) && g.isTypeAllowed(name) {
+				names = append(names, regexp.QuoteMeta(name))
+			}
+		}
+	}
+
+	if len(names) == 0 {
+		return regexp.MustCompile("")
+	}
+
+	// prefer the longest names
+	sort.Slice(names, func(i, j int) bool {
+		return len(names[i]) > len(names[j])
+	})
+
+	return regexp.MustCompile("{@link [^}]*}|`[^`]*`|\\b[a-z]\\w*\\.[A-Za-z_]\\w*|\\b(?:" + strings.Join(names, "|") + ")\\b")
+}
@@ -3,6 +3,7 @@ package tygojaPB
 import (
 	"fmt"
 	"go/ast"
+	"go/types"
 	"strings"
 )
 
@@ -19,6 +20,13 @@ type groupContext struct {
 // "func Count() int"
 // or
 // "func (s *Counter) total() int"
+//
+// Package level functions are written as interfaces with a single call signature
+// so that same-named functions sharing a namespace (eg. from packages with the same
+// name or after TypeNameFormatter) are merged by TS into an overload set
+// instead of resulting in a duplicate identifier error.
+// The overloads order follows the sorted package import paths and doesn't
+// depend on the Config.Packages iteration order.
 func (g *PackageGenerator) writeFuncDecl(s *strings.Builder, decl *ast.FuncDecl, depth int) {
 	if decl.Name == nil || len(decl.Name.Name) == 0 || decl.Name.Name[0] < 'A' || decl.Name.Name[0] > 'Z' {
 		return // unexported function/method
@@ -41,6 +49,16 @@ func (g *PackageGenerator) writeFuncDecl(s *strings.Builder, decl *ast.FuncDecl,
 			g.markAsGenerated(originalMethodName)
 		}
 
+		if !g.isDeclarationAllowed(DeclarationKindFunc, originalMethodName, decl.Doc) {
+			return
+		}
+
+		defer g.enterGraphNode(DeclarationKindFunc, originalMethodName)()
+
+		defer g.popTypeParams(g.pushTypeParams(typeParamNames(decl.Type.TypeParams)))
+
+		methodName = g.formatDeclName(DeclarationKindFunc, methodName)
+
 		g.writeStartModifier(s, depth)
 		s.WriteString("interface ")
 
@@ -51,15 +69,18 @@ func (g *PackageGenerator) writeFuncDecl(s *strings.Builder, decl *ast.FuncDecl,
 		}
 
 		if decl.Type.TypeParams != nil {
-			g.writeTypeParamsFields(s, decl.Type.TypeParams.List)
+			g.writeConstrainedTypeParamsFields(s, decl.Type.TypeParams.List, depth)
 		}
 
-		s.WriteString(" {\n")
-		if decl.Doc != nil {
-			g.writeCommentGroup(s, decl.Doc, depth+1)
+		funcType := decl.Type
+		if g.conf.NarrowInterfaceReturns {
+			funcType = g.narrowedFuncType(decl)
 		}
+
+		s.WriteString(" {\n")
+		g.writeCommentGroup(s, decl.Doc, depth+1, append(g.resultsJSDoc(decl.Type), g.docExamples(originalMethodName)...)...)
 		g.writeIndent(s, depth+1)
-		g.writeType(s, decl.Type, depth+1)
+		g.writeFuncType(s, funcType, depth, false, "", optionalParamsFrom(decl.Doc))
 		s.WriteString("\n")
 		g.writeIndent(s, depth)
 		s.WriteString("}\n")
@@ -73,16 +94,7 @@ func (g *PackageGenerator) writeFuncDecl(s *strings.Builder, decl *ast.FuncDecl,
 			recvType = p.X
 		}
 
-		var recvName string
-		switch recv := recvType.(type) {
-		case *ast.Ident:
-			recvName = recv.Name
-		case *ast.IndexExpr:
-		case *ast.IndexListExpr:
-			if v, ok := recv.X.(*ast.Ident); ok {
-				recvName = v.Name
-			}
-		}
+		recvName := receiverName(recvType)
 
 		if !g.isTypeAllowed(recvName) {
 			return
@@ -90,18 +102,34 @@ func (g *PackageGenerator) writeFuncDecl(s *strings.Builder, decl *ast.FuncDecl,
 			g.markAsGenerated(recvName)
 		}
 
+		if (g.conf.StructAsType || g.isAliasType(recvName)) && g.isStructType(recvName) {
+			return // already inlined in the struct type alias (see writeStructMethods)
+		}
+
+		if !g.isDeclarationAllowed(DeclarationKindMethod, recvName+"."+originalMethodName, decl.Doc) {
+			return
+		}
+
+		defer g.enterGraphNode(DeclarationKindMethod, recvName+"."+originalMethodName)()
+
+		defer g.pushReceiverTypeParams(recvType)()
+
 		g.writeStartModifier(s, depth)
 		s.WriteString("interface ")
 
-		g.writeType(s, recvType, depth)
+		recvSB := new(strings.Builder)
+		g.writeReceiverType(recvSB, recvType, depth)
+		s.WriteString(recvSB.String())
 
 		s.WriteString(" {\n")
-		if decl.Doc != nil {
-			g.writeCommentGroup(s, decl.Doc, depth+1)
-		}
+		g.writeCommentGroup(s, decl.Doc, depth+1, append(g.resultsJSDoc(decl.Type), g.docExamples(recvName+"_"+originalMethodName)...)...)
 		g.writeIndent(s, depth+1)
-		s.WriteString(methodName)
-		g.writeType(s, decl.Type, depth+1)
+		writePropertyName(s, methodName)
+		var thisParam string
+		if g.conf.ExplicitThisParam {
+			thisParam = recvSB.String()
+		}
+		g.writeFuncType(s, decl.Type, depth, false, thisParam, optionalParamsFrom(decl.Doc))
 		s.WriteString("\n")
 		g.writeIndent(s, depth)
 		s.WriteString("}\n")
@@ -161,17 +189,49 @@ func (g *PackageGenerator) writeTypeSpec(s *strings.Builder, ts *ast.TypeSpec, g
 		g.markAsGenerated(typeName)
 	}
 
-	if ts.Doc != nil {
-		// the spec has its own comment, which overrules the grouped comment
-		g.writeCommentGroup(s, ts.Doc, depth)
-	} else {
-		g.writeCommentGroup(s, group.doc, depth)
+	defer g.popTypeParams(g.pushTypeParams(typeParamNames(ts.TypeParams)))
+
+	// the spec has its own comment, which overrules the grouped comment
+	doc := ts.Doc
+	if doc == nil {
+		doc = group.doc
+	}
+
+	if !g.isDeclarationAllowed(DeclarationKindType, typeName, doc) {
+		return
+	}
+
+	defer g.enterGraphNode(DeclarationKindType, typeName)()
+
+	declName := g.formatDeclName(DeclarationKindType, typeName)
+
+	g.writeCommentGroup(s, doc, depth, g.docExamples(typeName)...)
+
+	if members := g.enumMembers(typeName); len(members) > 0 {
+		g.writeEnum(s, declName, members, depth)
+		return
 	}
 
 	switch v := ts.Type.(type) {
 	case *ast.StructType:
 		// eg. "type X struct { ... }"
 
+		if g.conf.CollapseSingleFieldStructs {
+			if field := singleExportedField(v); field != nil && !g.hasMethods(typeName) {
+				g.writeStartModifier(s, depth)
+				s.WriteString("type ")
+				s.WriteString(declName)
+
+				if ts.TypeParams != nil {
+					g.writeTypeParamsFields(s, ts.TypeParams.List)
+				}
+
+				s.WriteString(" = ")
+				g.writeType(s, field.Type, depth)
+				break
+			}
+		}
+
 		var extendTypeName string
 
 		// convert embeded structs to "extends SUB_TYPE" declaration
@@ -182,43 +242,49 @@ func (g *PackageGenerator) writeTypeSpec(s *strings.Builder, ts *ast.TypeSpec, g
 		if v.Fields != nil {
 			var embeds []*ast.Field
 			for _, f := range v.Fields.List {
+				if _, _, ok := g.inlinedEmbedStruct(f); ok {
+					continue // the promoted fields are written directly in the struct body
+				}
+
 				if len(f.Names) == 0 || f.Names[0].Name == "" {
 					embeds = append(embeds, f)
 				}
 			}
 
-			if len(embeds) > 0 {
-				extendTypeName = "_s" + PseudorandomString(6)
+			identSB := new(strings.Builder)
+			embedsSB := new(strings.Builder)
+			for _, f := range embeds {
+				// the embedded pointers are extended as their base type since the
+				// promoted fields are accessed directly on the parent object
+				// (similar to Go, a nil embedded pointer is not reflected in the typings)
+				typ := f.Type
+				if p, isPointer := typ.(*ast.StarExpr); isPointer {
+					typ = p.X
+				}
 
-				genericArgs := map[string]struct{}{}
-				identSB := new(strings.Builder)
-				embedsSB := new(strings.Builder)
-				for i, f := range embeds {
-					if i > 0 {
-						embedsSB.WriteString("&")
-					}
+				identSB.Reset()
+				g.writeType(identSB, typ, depth, optionParenthesis, optionExtends)
+				ident := identSB.String()
 
-					typ := f.Type
-					if p, isPointer := typ.(*ast.StarExpr); isPointer {
-						typ = p.X
-					}
+				// skip the embeds mapped to non-object types (eg. "sync.Mutex" => "any")
+				// since they can't be extended
+				if _, ok := tsBuiltinTypes[ident]; ok && ident != "object" {
+					continue
+				}
 
-					identSB.Reset()
-					g.writeType(identSB, typ, depth, optionParenthesis, optionExtends)
-					ident := identSB.String()
+				if embedsSB.Len() > 0 {
+					embedsSB.WriteString("&")
+				}
 
-					if idx := strings.Index(ident, "<"); idx > 1 { // has at least 2 characters for <>
-						genericArgs[ident[idx+1:len(ident)-1]] = struct{}{}
-					}
+				embedsSB.WriteString(ident)
+			}
 
-					embedsSB.WriteString(ident)
-				}
+			if embedsSB.Len() > 0 {
+				extendTypeName = "_s" + PseudorandomString(6)
 
-				if len(genericArgs) > 0 {
-					args := make([]string, 0, len(genericArgs))
-					for g := range genericArgs {
-						args = append(args, g)
-					}
+				// only the struct type params could be declared as alias params
+				// (eg. "type _sAbc<T> = c.Pair<string, T>")
+				if args := embedsTypeParams(embeds, ts.TypeParams); len(args) > 0 {
 					extendTypeName = extendTypeName + "<" + strings.Join(args, ",") + ">"
 				}
 
@@ -231,34 +297,105 @@ func (g *PackageGenerator) writeTypeSpec(s *strings.Builder, ts *ast.TypeSpec, g
 			}
 		}
 
-		g.writeStartModifier(s, depth)
-		s.WriteString("interface ")
-		s.WriteString(typeName)
+		// Go type aliases can't be merged with other declarations
+		// so they are always written as TS type alias
+		// (eg. "type Pair[A, B any] = struct{ ... }")
+		if g.conf.StructAsType || ts.Assign.IsValid() {
+			// eg. "type X = _sAbc & { ... }"
+			g.writeStartModifier(s, depth)
+			s.WriteString("type ")
+			s.WriteString(declName)
+
+			if ts.TypeParams != nil {
+				g.writeTypeParamsFields(s, ts.TypeParams.List)
+			}
 
-		if ts.TypeParams != nil {
-			g.writeTypeParamsFields(s, ts.TypeParams.List)
-		}
+			s.WriteString(" = ")
 
-		if extendTypeName != "" {
-			s.WriteString(" extends ")
-			s.WriteString(extendTypeName)
+			if extendTypeName != "" {
+				s.WriteString(extendTypeName)
+				s.WriteString(" & ")
+			}
+
+			methods := append(g.structMethods(typeName), g.inlinedEmbedMethods(v.Fields.List, map[string]struct{}{})...)
+
+			if g.conf.CompactEmptyTypes && isEmptyStruct(v) && len(methods) == 0 {
+				s.WriteString("{}")
+				break
+			}
+
+			s.WriteString("{\n")
+			g.writeStructFields(s, v.Fields.List, depth)
+			g.writeStructMethods(s, methods, depth)
+			g.writeIndent(s, depth)
+			s.WriteString("}")
+		} else {
+			g.writeStartModifier(s, depth)
+			s.WriteString("interface ")
+			s.WriteString(declName)
+
+			if ts.TypeParams != nil {
+				g.writeTypeParamsFields(s, ts.TypeParams.List)
+			}
+
+			if extendTypeName != "" {
+				s.WriteString(" extends ")
+				s.WriteString(extendTypeName)
+			}
+
+			embedMethods := g.inlinedEmbedMethods(v.Fields.List, map[string]struct{}{})
+
+			if g.conf.CompactEmptyTypes && isEmptyStruct(v) && len(embedMethods) == 0 {
+				s.WriteString(" {}")
+				break
+			}
+
+			s.WriteString(" {\n")
+			g.writeStructFields(s, v.Fields.List, depth)
+			g.writeStructMethods(s, embedMethods, depth)
+			g.writeIndent(s, depth)
+			s.WriteString("}")
 		}
 
-		s.WriteString(" {\n")
-		g.writeStructFields(s, v.Fields.List, depth)
-		g.writeIndent(s, depth)
-		s.WriteString("}")
+		if exists(g.conf.GeneratePartialVariants, g.pkg.ID+"."+typeName) {
+			s.WriteString("\n")
+			g.writePartialVariant(s, ts, declName, depth)
+		}
 	case *ast.InterfaceType:
 		// eg. "type X interface { ... }"
 
+		// constraint interfaces with type sets are generated as union type alias
+		// (eg. "type Number interface { ~int | ~float64 }" -> "type Number = number")
+		if terms := typeSetElements(v); len(terms) > 0 {
+			g.writeStartModifier(s, depth)
+			s.WriteString("type ")
+			s.WriteString(declName)
+
+			if ts.TypeParams != nil {
+				g.writeTypeParamsFields(s, ts.TypeParams.List)
+			}
+
+			s.WriteString(" = ")
+			g.writeTypeSetElements(s, terms, depth)
+			break
+		}
+
 		g.writeStartModifier(s, depth)
 		s.WriteString("interface ")
-		s.WriteString(typeName)
+		s.WriteString(declName)
 
 		if ts.TypeParams != nil {
 			g.writeTypeParamsFields(s, ts.TypeParams.List)
 		}
 
+		// convert the embedded interfaces to "extends A, B" declaration
+		// (Go doesn't allow embedding interfaces with conflicting methods
+		// so there is no need for the structs intersection type workaround)
+		if embeds := g.interfaceEmbeds(v, depth); len(embeds) > 0 {
+			s.WriteString(" extends ")
+			s.WriteString(strings.Join(embeds, ", "))
+		}
+
 		s.WriteString(" {\n")
 
 		// fallback so that it doesn't report an error when attempting
@@ -266,27 +403,77 @@ func (g *PackageGenerator) writeTypeSpec(s *strings.Builder, ts *ast.TypeSpec, g
 		g.writeIndent(s, depth+1)
 		s.WriteString("[key:string]: any;\n")
 
+		if g.conf.ExplicitThisParam {
+			thisSB := new(strings.Builder)
+			thisSB.WriteString(declName)
+			if ts.TypeParams != nil {
+				g.writeTypeParamsFields(thisSB, ts.TypeParams.List)
+			}
+			g.interfaceThisType = thisSB.String()
+		}
+
 		g.writeInterfaceFields(s, v.Methods.List, depth)
 		g.writeIndent(s, depth)
 		s.WriteString("}")
+
+		if g.isSumTypeInterface(typeName) {
+			s.WriteString("\n")
+			g.writeSumType(s, typeName, depth)
+		}
 	case *ast.FuncType:
 		// eg. "type Handler func() any"
 
+		// func types without methods are generated as function type alias
+		// (eg. "type Handler = () => any")
+		if !g.hasMethods(typeName) {
+			g.writeStartModifier(s, depth)
+			s.WriteString("type ")
+			s.WriteString(declName)
+
+			if ts.TypeParams != nil {
+				g.writeTypeParamsFields(s, ts.TypeParams.List)
+			}
+
+			s.WriteString(" = ")
+			g.writeType(s, v, depth, optionParenthesis)
+			break
+		}
+
+		// otherwise fallback to a callable interface to allow merging its methods
 		g.writeStartModifier(s, depth)
 		s.WriteString("interface ")
-		s.WriteString(typeName)
+		s.WriteString(declName)
 
 		if ts.TypeParams != nil {
 			g.writeTypeParamsFields(s, ts.TypeParams.List)
 		}
 
 		s.WriteString(" {")
-		g.writeFuncType(s, v, depth, false)
+		g.writeFuncType(s, v, depth, false, "", -1)
 		g.writeIndent(s, depth)
 		s.WriteString("}")
 	default:
+		// type alias without methods (eg. "type Vec[T any] = []T")
+		if ts.Assign.IsValid() && !g.hasMethods(typeName) {
+			g.writeStartModifier(s, depth)
+			s.WriteString("type ")
+			s.WriteString(declName)
+
+			if ts.TypeParams != nil {
+				g.writeTypeParamsFields(s, ts.TypeParams.List)
+			}
+
+			s.WriteString(" = ")
+			g.writeType(s, ts.Type, depth)
+			break
+		}
+
 		// other Go type declarations like "type JsonArray []any"
 		// (note: we don't use "type X = Y", but "interface X extends Y"  syntax to allow later defining methods to the X type)
+		//
+		// eg. "type IDs []string" with "func (ids IDs) Contains(id string) bool" method will be generated as:
+		//   interface IDs extends Array<string>{}
+		//   interface IDs { Contains(id: string): boolean }
 
 		var baseType string
 		subSB := new(strings.Builder)
@@ -295,13 +482,32 @@ func (g *PackageGenerator) writeTypeSpec(s *strings.Builder, ts *ast.TypeSpec, g
 		// primitives can't be extended so we use their Object equivivalents
 		case "number", "string", "boolean":
 			baseType = strings.ToUpper(string(baseType[0])) + baseType[1:]
+		case "bigint":
+			baseType = "BigInt"
 		case "any":
 			baseType = BaseTypeAny
+		default:
+			if baseType == g.conf.NumberType || (g.conf.DistinguishIntFloat && exists(goNumericTypes, baseType)) {
+				baseType = "Number"
+			} else if baseType == g.conf.BoolType {
+				baseType = "Boolean"
+			} else if baseType == g.conf.StringType {
+				baseType = "String"
+			} else if baseType == g.conf.DefaultFallbackType {
+				baseType = BaseTypeAny
+			}
+		}
+
+		// the primitive Object equivalents could be shadowed by a package declaration
+		// (eg. "type Number interface { ~int | ~float64 }")
+		switch baseType {
+		case "Number", "Boolean", "String", "BigInt":
+			baseType = g.globalTypeRef(baseType)
 		}
 
 		g.writeStartModifier(s, depth)
 		s.WriteString("interface ")
-		s.WriteString(typeName)
+		s.WriteString(declName)
 
 		if ts.TypeParams != nil {
 			g.writeTypeParamsFields(s, ts.TypeParams.List)
@@ -321,6 +527,29 @@ func (g *PackageGenerator) writeTypeSpec(s *strings.Builder, ts *ast.TypeSpec, g
 	}
 }
 
+// globalTypeRef returns the specified JS global type reference
+// qualified with "globalThis." if a current package type declaration
+// with the same generated name shadows it within the package namespace.
+func (g *PackageGenerator) globalTypeRef(name string) string {
+	if g.pkg == nil || g.pkg.Types == nil {
+		return name
+	}
+
+	scope := g.pkg.Types.Scope()
+
+	for _, typeName := range scope.Names() {
+		if _, ok := scope.Lookup(typeName).(*types.TypeName); !ok {
+			continue
+		}
+
+		if g.formatDeclName(DeclarationKindType, typeName) == name {
+			return "globalThis." + name
+		}
+	}
+
+	return name
+}
+
 // Writing of value specs, which are exported const expressions like
 // const SomeValue = 3
 func (g *PackageGenerator) writeValueSpec(s *strings.Builder, vs *ast.ValueSpec, group *groupContext, depth int) {
@@ -336,74 +565,513 @@ func (g *PackageGenerator) writeValueSpec(s *strings.Builder, vs *ast.ValueSpec,
 			g.markAsGenerated(name.Name)
 		}
 
-		constName := name.Name
+		constName := g.formatDeclName(DeclarationKindConst, name.Name)
 		if isReservedIdentifier(constName) {
 			constName = "_" + constName
 		}
 
-		if vs.Doc != nil { // The spec has its own comment, which overrules the grouped comment.
-			g.writeCommentGroup(s, vs.Doc, depth)
-		} else if group.isGroupedDeclaration {
-			g.writeCommentGroup(s, group.doc, depth)
+		// the spec has its own comment, which overrules the grouped comment
+		// (for grouped declarations the trailing spec comment is also
+		// considered as spec comment to allow documenting the individual members)
+		doc := vs.Doc
+		var isTrailingDoc bool
+		if doc == nil && group.isGroupedDeclaration && vs.Comment != nil {
+			doc = vs.Comment
+			isTrailingDoc = true
+		}
+		if doc == nil {
+			doc = group.doc
+		}
+
+		// write the excluded const in a discard builder to preserve the group iota and type tracking
+		// (the enum members are written as part of their type declaration)
+		out := s
+		leaveGraphNode := func() {}
+		if !g.isDeclarationAllowed(DeclarationKindConst, name.Name, doc) || g.enumTypeOf(name.Name) != "" {
+			out = new(strings.Builder)
+		} else {
+			leaveGraphNode = g.enterGraphNode(DeclarationKindConst, name.Name)
 		}
 
+		g.writeCommentGroup(out, doc, depth)
+
 		hasExplicitValue := len(vs.Values) > i
 		if hasExplicitValue {
 			group.groupType = ""
 		}
 
-		g.writeStartModifier(s, depth)
-		s.WriteString("const ")
-		s.WriteString(constName)
+		g.writeStartModifier(out, depth)
+		out.WriteString("const ")
+		out.WriteString(constName)
+
+		// infer the type of the untyped type conversion values (eg. "Level(1)")
+		typ := vs.Type
+		if typ == nil && hasExplicitValue {
+			if call, ok := vs.Values[i].(*ast.CallExpr); ok {
+				typ, _ = g.conversionType(call)
+			}
+		}
 
-		if vs.Type != nil {
-			s.WriteString(": ")
+		hasType := true
+		if typ != nil {
+			out.WriteString(": ")
 
 			tempSB := &strings.Builder{}
-			g.writeType(tempSB, vs.Type, depth, optionParenthesis)
+			g.writeType(tempSB, typ, depth, optionParenthesis)
 			typeString := tempSB.String()
 
-			s.WriteString(typeString)
+			out.WriteString(typeString)
 			group.groupType = typeString
 		} else if group.groupType != "" && !hasExplicitValue {
-			s.WriteString(": ")
-			s.WriteString(group.groupType)
+			out.WriteString(": ")
+			out.WriteString(group.groupType)
+		} else {
+			hasType = false
 		}
 
-		s.WriteString(" = ")
+		// the complex constants don't have TS literal representation
+		// so only their type is written
+		if g.isComplexConst(name.Name) {
+			if !hasType {
+				out.WriteString(": ")
+				out.WriteString(g.conf.ComplexType)
+			}
+			out.WriteByte('\n')
 
-		if hasExplicitValue {
-			val := vs.Values[i]
-			tempSB := &strings.Builder{}
-			g.writeType(tempSB, val, depth, optionParenthesis)
+			g.warn(name, "unsupported complex constant value")
 
-			valueString := tempSB.String()
-			if isProbablyIotaType(valueString) {
-				iotaV, err := basicIotaOffsetValueParse(valueString)
-				if err != nil {
-					// fallback
-					group.groupValue = valueString
+			leaveGraphNode()
+			continue
+		}
+
+		out.WriteString(" = ")
+
+		// prefer the type checker evaluated value when available
+		// (eg. for "1 << iota" bit flags or other constant expressions)
+		//
+		// note: the value expression is not written in this case to avoid
+		// registering its identifiers (eg. "iota") as unknown types
+		valueString, ok := g.evaluatedConstValue(name.Name)
+		if !ok {
+			if hasExplicitValue {
+				val := vs.Values[i]
+				tempSB := &strings.Builder{}
+				g.writeType(tempSB, val, depth, optionParenthesis)
+
+				valueString = tempSB.String()
+				if isProbablyIotaType(valueString) {
+					iotaV, err := basicIotaOffsetValueParse(valueString)
+					if err != nil {
+						// fallback
+						group.groupValue = valueString
+					} else {
+						group.iotaOffset = iotaV
+						group.groupValue = "iota"
+						valueString = fmt.Sprint(group.iotaValue + group.iotaOffset)
+					}
 				} else {
-					group.iotaOffset = iotaV
-					group.groupValue = "iota"
+					group.groupValue = valueString
+				}
+			} else { // We must use the previous value or +1 in case of iota
+				valueString = group.groupValue
+				if group.groupValue == "iota" {
 					valueString = fmt.Sprint(group.iotaValue + group.iotaOffset)
 				}
-			} else {
-				group.groupValue = valueString
-			}
-			s.WriteString(valueString)
-		} else { // We must use the previous value or +1 in case of iota
-			valueString := group.groupValue
-			if group.groupValue == "iota" {
-				valueString = fmt.Sprint(group.iotaValue + group.iotaOffset)
 			}
-			s.WriteString(valueString)
 		}
 
-		if vs.Comment != nil {
-			s.WriteString(" // " + vs.Comment.Text())
+		out.WriteString(valueString)
+
+		if vs.Comment != nil && !isTrailingDoc {
+			out.WriteString(" // " + vs.Comment.Text())
 		} else {
-			s.WriteByte('\n')
+			out.WriteByte('\n')
+		}
+
+		leaveGraphNode()
+	}
+}
+
+// singleExportedField returns the struct field if the struct has
+// exactly one named exported field, otherwise returns nil.
+func singleExportedField(st *ast.StructType) *ast.Field {
+	if st.Fields == nil || len(st.Fields.List) != 1 {
+		return nil
+	}
+
+	f := st.Fields.List[0]
+	if len(f.Names) != 1 || !f.Names[0].IsExported() {
+		return nil
+	}
+
+	return f
+}
+
+// hasMethods checks whether the current package has at least one
+// method declaration with typeName as a receiver.
+func (g *PackageGenerator) hasMethods(typeName string) bool {
+	for _, file := range g.syntaxFiles() {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 {
+				continue
+			}
+
+			if receiverName(fn.Recv.List[0].Type) == typeName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// receiverName extracts the base type name of a method receiver expression
+// (eg. "T", "*T", "T[A]", "*T[A, B]").
+func receiverName(recvType ast.Expr) string {
+	if p, isPointer := recvType.(*ast.StarExpr); isPointer {
+		recvType = p.X
+	}
+
+	switch recv := recvType.(type) {
+	case *ast.Ident:
+		return recv.Name
+	case *ast.IndexExpr:
+		if v, ok := recv.X.(*ast.Ident); ok {
+			return v.Name
+		}
+	case *ast.IndexListExpr:
+		if v, ok := recv.X.(*ast.Ident); ok {
+			return v.Name
+		}
+	}
+
+	return ""
+}
+
+// receiverTypeParamNames extracts the type parameter names
+// of a generic method receiver expression (eg. "A" and "B" for "*T[A, B]").
+func receiverTypeParamNames(recvType ast.Expr) []string {
+	params := receiverTypeParams(recvType)
+
+	names := make([]string, 0, len(params))
+	for _, name := range params {
+		if name != "_" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// receiverTypeParams returns all positional type parameter names
+// of a generic method receiver, including the blank ones.
+func receiverTypeParams(recvType ast.Expr) []string {
+	if p, isPointer := recvType.(*ast.StarExpr); isPointer {
+		recvType = p.X
+	}
+
+	var indices []ast.Expr
+	switch recv := recvType.(type) {
+	case *ast.IndexExpr:
+		indices = []ast.Expr{recv.Index}
+	case *ast.IndexListExpr:
+		indices = recv.Indices
+	}
+
+	names := make([]string, 0, len(indices))
+	for _, idx := range indices {
+		if ident, ok := idx.(*ast.Ident); ok {
+			names = append(names, ident.Name)
+		}
+	}
+
+	return names
+}
+
+// pushReceiverTypeParams registers the specified method receiver type
+// parameters as in scope (see pushTypeParams) and returns a function
+// to unregister them.
+//
+// The receiver type parameters are renamed to the ones of the receiver
+// type declaration because TS requires all merged interface
+// declarations to have identical type parameters
+// (eg. "func (p Pair[_, B]) Value() B" is written as "interface Pair<K,V> { Value(): V }").
+func (g *PackageGenerator) pushReceiverTypeParams(recvType ast.Expr) func() {
+	names := g.pushTypeParams(receiverTypeParamNames(recvType))
+
+	prevRenames := g.typeParamRenames
+	g.typeParamRenames = map[string]string{}
+
+	if ts := g.localTypeSpec(receiverName(recvType)); ts != nil {
+		declared := typeParamNames(ts.TypeParams)
+		for i, name := range receiverTypeParams(recvType) {
+			if i < len(declared) && name != "_" {
+				g.typeParamRenames[name] = declaredTypeParamName(declared[i], i)
+			}
+		}
+	}
+
+	return func() {
+		g.popTypeParams(names)
+		g.typeParamRenames = prevRenames
+	}
+}
+
+// writeReceiverType writes the specified method receiver type
+// using the type parameter names of its declaration (see pushReceiverTypeParams).
+func (g *PackageGenerator) writeReceiverType(s *strings.Builder, recvType ast.Expr, depth int) {
+	if p, isPointer := recvType.(*ast.StarExpr); isPointer {
+		recvType = p.X
+	}
+
+	if _, ok := recvType.(*ast.Ident); !ok {
+		if ts := g.localTypeSpec(receiverName(recvType)); ts != nil && ts.TypeParams != nil {
+			s.WriteString(g.formatDeclName(DeclarationKindType, ts.Name.Name))
+			g.writeTypeParamsFields(s, ts.TypeParams.List)
+			return
+		}
+	}
+
+	g.writeType(s, recvType, depth)
+}
+
+// writePartialVariant writes a "Partial" utility type alias of the specified struct type spec
+// (eg. "type FooPartial<T> = Partial<Foo<T>>").
+//
+// declName is the already formatted name of the struct type.
+func (g *PackageGenerator) writePartialVariant(s *strings.Builder, ts *ast.TypeSpec, declName string, depth int) {
+	params := new(strings.Builder)
+	if ts.TypeParams != nil {
+		g.writeTypeParamsFields(params, ts.TypeParams.List)
+	}
+
+	g.writeStartModifier(s, depth)
+	s.WriteString("type ")
+	s.WriteString(declName)
+	s.WriteString("Partial")
+	s.WriteString(params.String())
+	s.WriteString(" = Partial<")
+	s.WriteString(declName)
+	s.WriteString(params.String())
+	s.WriteString(">")
+}
+
+// interfaceEmbeds returns the resolved TS types of the embedded
+// interfaces of the specified interface type declaration.
+//
+// Embedded types that can't be extended (eg. "comparable" or mapped to "any") are skipped.
+func (g *PackageGenerator) interfaceEmbeds(iface *ast.InterfaceType, depth int) []string {
+	if iface.Methods == nil {
+		return nil
+	}
+
+	var result []string
+
+	for _, f := range iface.Methods.List {
+		if len(f.Names) > 0 {
+			continue // method
+		}
+
+		switch t := f.Type.(type) {
+		case *ast.Ident:
+			if t.Name == "comparable" || isGoBasicType(t.Name) {
+				continue
+			}
+		case *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr:
+		default:
+			continue // type set element (eg. "~int | ~string")
+		}
+
+		sb := new(strings.Builder)
+		g.writeType(sb, f.Type, depth, optionExtends)
+		embed := sb.String()
+
+		if _, ok := tsBuiltinTypes[embed]; ok {
+			continue
+		}
+
+		result = append(result, embed)
+	}
+
+	return result
+}
+
+// isAliasType checks whether name is a type alias declared in the current package.
+func (g *PackageGenerator) isAliasType(name string) bool {
+	if g.pkg == nil || g.pkg.Types == nil {
+		return false
+	}
+
+	obj, ok := g.pkg.Types.Scope().Lookup(name).(*types.TypeName)
+
+	return ok && obj.IsAlias()
+}
+
+// namedBasicType returns the underlying basic type name of the
+// specified method-less named type declared in the current package
+// (eg. "string" for "type Email string").
+func (g *PackageGenerator) namedBasicType(name string) (string, bool) {
+	if g.pkg == nil || g.pkg.Types == nil {
+		return "", false
+	}
+
+	obj, ok := g.pkg.Types.Scope().Lookup(name).(*types.TypeName)
+	if !ok || obj.IsAlias() {
+		return "", false
+	}
+
+	named, ok := obj.Type().(*types.Named)
+	if !ok || named.NumMethods() > 0 || named.TypeParams().Len() > 0 {
+		return "", false
+	}
+
+	basic, ok := named.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsUntyped != 0 || basic.Kind() == types.UnsafePointer {
+		return "", false
+	}
+
+	return basic.Name(), true
+}
+
+// inlinableNamedType returns the definition type expression of the specified
+// method-less named basic, slice, array or map type declared
+// in the current package (see Config.InlineNamedTypes).
+func (g *PackageGenerator) inlinableNamedType(name string) (ast.Expr, bool) {
+	if g.pkg == nil || g.pkg.Types == nil {
+		return nil, false
+	}
+
+	if _, ok := g.inlinedNamedTypes[name]; ok {
+		return nil, false // self referencing type
+	}
+
+	if _, ok := g.namedBasicType(name); !ok {
+		obj, ok := g.pkg.Types.Scope().Lookup(name).(*types.TypeName)
+		if !ok || obj.IsAlias() {
+			return nil, false
+		}
+
+		named, ok := obj.Type().(*types.Named)
+		if !ok || named.NumMethods() > 0 || named.TypeParams().Len() > 0 {
+			return nil, false
 		}
+
+		switch named.Underlying().(type) {
+		case *types.Slice, *types.Array, *types.Map:
+		default:
+			return nil, false
+		}
+	}
+
+	ts := g.localTypeSpec(name)
+	if ts == nil {
+		return nil, false
 	}
+
+	return ts.Type, true
+}
+
+// isStructType checks whether name is a struct type declared in the current package.
+func (g *PackageGenerator) isStructType(name string) bool {
+	if g.pkg == nil || g.pkg.Types == nil {
+		return false
+	}
+
+	obj, ok := g.pkg.Types.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return false
+	}
+
+	_, ok = obj.Type().Underlying().(*types.Struct)
+
+	return ok
+}
+
+// structMethods returns the exported method declarations
+// with typeName as receiver from the current package.
+func (g *PackageGenerator) structMethods(typeName string) []*ast.FuncDecl {
+	var result []*ast.FuncDecl
+
+	for _, file := range g.syntaxFiles() {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || !fn.Name.IsExported() {
+				continue
+			}
+
+			if receiverName(fn.Recv.List[0].Type) != typeName {
+				continue
+			}
+
+			if !g.isDeclarationAllowed(DeclarationKindMethod, typeName+"."+fn.Name.Name, fn.Doc) {
+				continue
+			}
+
+			result = append(result, fn)
+		}
+	}
+
+	return result
+}
+
+// writeStructMethods writes the specified struct methods as part
+// of the struct type literal (see Config.StructAsType).
+func (g *PackageGenerator) writeStructMethods(s *strings.Builder, methods []*ast.FuncDecl, depth int) {
+	for _, fn := range methods {
+		recvType := fn.Recv.List[0].Type
+		if p, isPointer := recvType.(*ast.StarExpr); isPointer {
+			recvType = p.X
+		}
+
+		methodName := fn.Name.Name
+		if g.conf.MethodNameFormatter != nil {
+			methodName = g.conf.MethodNameFormatter(methodName)
+		}
+
+		popTypeParams := g.pushReceiverTypeParams(recvType)
+
+		g.writeCommentGroup(s, fn.Doc, depth+1, append(g.resultsJSDoc(fn.Type), g.docExamples(receiverName(recvType)+"_"+fn.Name.Name)...)...)
+		g.writeIndent(s, depth+1)
+		writePropertyName(s, methodName)
+		var thisParam string
+		if g.conf.ExplicitThisParam {
+			recvSB := new(strings.Builder)
+			g.writeReceiverType(recvSB, recvType, depth)
+			thisParam = recvSB.String()
+		}
+		g.writeFuncType(s, fn.Type, depth, false, thisParam, optionalParamsFrom(fn.Doc))
+		s.WriteString("\n")
+
+		popTypeParams()
+	}
+}
+
+// embedsTypeParams returns the names of the type params (in their
+// declaration order) that are referenced by the specified embedded fields.
+func embedsTypeParams(embeds []*ast.Field, typeParams *ast.FieldList) []string {
+	if typeParams == nil {
+		return nil
+	}
+
+	used := map[string]struct{}{}
+	for _, f := range embeds {
+		ast.Inspect(f.Type, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok {
+				used[ident.Name] = struct{}{}
+			}
+			return true
+		})
+	}
+
+	result := []string{}
+	for _, f := range typeParams.List {
+		for _, name := range f.Names {
+			if _, ok := used[name.Name]; ok && name.Name != "_" {
+				result = append(result, name.Name)
+			}
+		}
+	}
+
+	return result
 }
@@ -2,12 +2,18 @@ package tygojaPB
 
 import (
 	"fmt"
-	"log"
+	"path/filepath"
+	"reflect"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"text/template"
+	"unicode/utf8"
 
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // Options for the writeType() method that can be used for extra context
@@ -34,13 +40,21 @@ func (g *PackageGenerator) writeStartModifier(s *strings.Builder, depth int) {
 }
 
 func (g *PackageGenerator) writeType(s *strings.Builder, t ast.Expr, depth int, options ...string) {
+	if g.handleType(s, t, depth, options...) {
+		return
+	}
+
+	g.addGraphEdge(t)
+
 	switch t := t.(type) {
 	case *ast.StarExpr:
 		if hasOption(optionParenthesis, options) {
 			s.WriteByte('(')
 		}
 
-		g.writeType(s, t.X, depth)
+		// multiple pointer levels are collapsed into a single nullable (eg. "**T" -> "T | undefined")
+		elem, _ := unwrapPointers(t)
+		g.writeType(s, elem, depth)
 
 		// allow undefined union only when not used in an "extends" expression or as return type
 		if !hasOption(optionExtends, options) && !hasOption(optionFunctionReturn, options) {
@@ -51,7 +65,8 @@ func (g *PackageGenerator) writeType(s *strings.Builder, t ast.Expr, depth int,
 			s.WriteByte(')')
 		}
 	case *ast.Ellipsis:
-		if v, ok := t.Elt.(*ast.Ident); ok && v.String() == "byte" {
+		// goja auto converts string to []byte for the variadic byte args
+		if !g.conf.VariadicByteAsArray && g.isUnmappedByte(t.Elt) {
 			s.WriteString("string")
 			break
 		}
@@ -71,20 +86,57 @@ func (g *PackageGenerator) writeType(s *strings.Builder, t ast.Expr, depth int,
 
 		s.WriteString("[]")
 	case *ast.ArrayType:
-		if v, ok := t.Elt.(*ast.Ident); ok && v.String() == "byte" && !hasOption(optionExtends, options) {
+		if g.conf.CompactEmptyTypes && isZeroLengthArray(t) {
+			s.WriteString("[]")
+			break
+		}
+
+		if g.isUnmappedByte(t.Elt) && !hasOption(optionExtends, options) {
 			// union type with string since depending where it is used
 			// goja auto converts string to []byte if the field expect that
 			s.WriteString("string|")
 		}
 
-		s.WriteString("Array<")
-		g.writeType(s, t.Elt, depth, optionParenthesis)
-		s.WriteString(">")
+		if g.conf.ArraySyntax != ArraySyntaxShorthand || hasOption(optionExtends, options) {
+			s.WriteString("Array<")
+			g.writeType(s, t.Elt, depth, optionParenthesis)
+			s.WriteString(">")
+			break
+		}
+
+		// eg. "User[]" or "(() => number)[]"
+		eltSB := new(strings.Builder)
+		g.writeType(eltSB, t.Elt, depth, optionParenthesis)
+		elt := eltSB.String()
+
+		if needsArrayElementParenthesis(elt) {
+			s.WriteString("(")
+			s.WriteString(elt)
+			s.WriteString(")")
+		} else {
+			s.WriteString(elt)
+		}
+
+		s.WriteString("[]")
 	case *ast.StructType:
+		if g.conf.CompactEmptyTypes && isEmptyStruct(t) {
+			s.WriteString("{}")
+			break
+		}
+
+		if g.conf.MaxInlineDepth > 0 && g.inlineDepth >= g.conf.MaxInlineDepth {
+			s.WriteString("any /* ")
+			s.WriteString(g.sourcePosition(t))
+			s.WriteString(" */")
+			break
+		}
+
+		g.inlineDepth++
 		s.WriteString("{\n")
 		g.writeStructFields(s, t.Fields.List, depth+1)
 		g.writeIndent(s, depth+1)
 		s.WriteByte('}')
+		g.inlineDepth--
 	case *ast.Ident:
 		v := t.String()
 
@@ -96,19 +148,62 @@ func (g *PackageGenerator) writeType(s *strings.Builder, t ast.Expr, depth int,
 			// try to find a matching js equivalent
 			switch v {
 			case "string":
-				v = "string"
+				v = g.conf.StringType
 			case "bool":
-				v = "boolean"
+				v = g.conf.BoolType
 			case "int", "int8", "int16", "int32", "int64",
 				"uint", "uint8", "uint16", "uint32", "uint64",
 				"float32", "float64",
 				"complex64", "complex128",
 				"uintptr", "byte", "rune":
-				v = "number"
+				v = g.numericType(v)
 			case "error":
-				v = "Error"
+				v = g.globalTypeRef(g.conf.ErrorType)
+			case "any":
+				v = g.conf.DefaultFallbackType
 			default:
+				if g.typeParams[v] > 0 {
+					// in scope type parameters are not real types
+					if rename, ok := g.typeParamRenames[v]; ok {
+						v = rename
+					}
+					break
+				}
+
+				if g.conf.InlineNamedTypes && !g.isTypeAllowed(v) {
+					if typ, ok := g.inlinableNamedType(v); ok {
+						if g.inlinedNamedTypes == nil {
+							g.inlinedNamedTypes = map[string]struct{}{}
+						}
+						g.inlinedNamedTypes[v] = struct{}{}
+						typeSB := new(strings.Builder)
+						g.writeType(typeSB, typ, depth, options...)
+						delete(g.inlinedNamedTypes, v)
+						v = typeSB.String()
+						break
+					}
+				}
+
+				if g.conf.ResolveNamedBasicTypes && !g.isTypeAllowed(v) {
+					if basic, ok := g.namedBasicType(v); ok {
+						basicSB := new(strings.Builder)
+						g.writeType(basicSB, ast.NewIdent(basic), depth)
+						v = basicSB.String()
+						break
+					}
+				}
+
+				name := g.formatDeclName(DeclarationKindType, v)
+
+				// bare identifier from a dot imported package
+				if ns, ok := g.dotImportNamespace(v); ok {
+					v = ns + "." + v
+					name = ns + "." + name
+				}
+
 				g.unknownTypes[v] = struct{}{}
+
+				v = name
 			}
 		}
 
@@ -124,10 +219,36 @@ func (g *PackageGenerator) writeType(s *strings.Builder, t ast.Expr, depth int,
 			s.WriteString(v)
 		} else {
 			g.unknownTypes[fullType] = struct{}{}
-			s.WriteString(fullType)
+			s.WriteString(fmt.Sprintf("%s.%s", t.X, g.formatDeclName(DeclarationKindType, t.Sel.Name)))
 		}
 	case *ast.MapType:
-		s.WriteString("_TygojaDict")
+		if g.conf.MapTypeTemplate != "" {
+			g.writeMapTemplate(s, t, depth)
+			break
+		}
+
+		keyType, ok := g.mapIndexKeyType(t.Key)
+		if !g.conf.ExpandMapTypes || !ok {
+			s.WriteString("_TygojaDict")
+			break
+		}
+
+		// strict mode (eg. "Record<string, unknown>")
+		if g.conf.DefaultFallbackType != defaultFallbackType {
+			s.WriteString("Record<")
+			s.WriteString(keyType)
+			s.WriteString(", ")
+			g.writeType(s, t.Value, depth)
+			s.WriteString(">")
+			break
+		}
+
+		// eg. "{ [key: string]: any }"
+		s.WriteString("{ [key: ")
+		s.WriteString(keyType)
+		s.WriteString("]: ")
+		g.writeType(s, t.Value, depth)
+		s.WriteString(" }")
 	case *ast.BasicLit:
 		s.WriteString(t.Value)
 	case *ast.ParenExpr:
@@ -141,49 +262,500 @@ func (g *PackageGenerator) writeType(s *strings.Builder, t ast.Expr, depth int,
 		s.WriteByte(' ')
 		g.writeType(s, t.Y, depth)
 	case *ast.InterfaceType:
+		// empty interface (eg. "...interface{}")
+		if t.Methods == nil || len(t.Methods.List) == 0 {
+			s.WriteString(g.conf.DefaultFallbackType)
+			break
+		}
+
+		// reference the identical named interface (if any) instead of repeatedly inlining it
+		if name, ok := g.namedInterface(t); ok {
+			g.writeType(s, ast.NewIdent(name), depth, options...)
+			break
+		}
+
 		s.WriteString("{\n")
-		g.writeInterfaceFields(s, t.Methods.List, depth)
+		g.writeInterfaceFields(s, t.Methods.List, depth+1)
 		g.writeIndent(s, depth+1)
 		s.WriteByte('}')
 	case *ast.FuncType:
-		g.writeFuncType(s, t, depth, hasOption(optionParenthesis, options))
+		if g.conf.IteratorsAsIterable {
+			if elems := iteratorElements(t); len(elems) > 0 {
+				g.writeIterable(s, "Iterable", elems, depth)
+				break
+			}
+		}
+
+		g.writeFuncType(s, t, depth, hasOption(optionParenthesis, options), "", -1)
 	case *ast.UnaryExpr:
 		if t.Op == token.TILDE {
 			// we just ignore the tilde token, in Typescript extended types are
 			// put into the generic typing itself, which we can't support yet.
 			g.writeType(s, t.X, depth)
+		} else if t.Op == token.SUB || t.Op == token.ADD {
+			// signed constant values (eg. "-1")
+			s.WriteString(t.Op.String())
+			g.writeType(s, t.X, depth)
 		} else {
-			// only log for now
-			log.Printf("unhandled unary expr: %v\n %T\n", t, t)
+			g.warn(t, "unhandled unary expression")
 		}
 	case *ast.IndexListExpr:
-		g.writeType(s, t.X, depth)
-		s.WriteByte('<')
-		for i, index := range t.Indices {
-			g.writeType(s, index, depth)
-			if i != len(t.Indices)-1 {
-				s.WriteString(", ")
-			}
-		}
-		s.WriteByte('>')
+		g.writeGenericInstance(s, t.X, t.Indices, depth)
 	case *ast.IndexExpr:
-		g.writeType(s, t.X, depth)
-		s.WriteByte('<')
-		g.writeType(s, t.Index, depth)
-		s.WriteByte('>')
-	case *ast.CallExpr, *ast.ChanType, *ast.CompositeLit:
+		g.writeGenericInstance(s, t.X, []ast.Expr{t.Index}, depth)
+	case *ast.ChanType:
+		if g.conf.ChannelType != "" {
+			g.writeIterable(s, g.conf.ChannelType, []ast.Expr{t.Value}, depth)
+		} else if g.conf.ChannelsAsAsyncIterable {
+			g.writeIterable(s, "AsyncIterable", []ast.Expr{t.Value}, depth)
+		} else {
+			s.WriteString("undefined")
+		}
+	case *ast.CallExpr:
+		// type conversion (eg. "Level(A)" -> "Level")
+		if fun, ok := g.conversionType(t); ok {
+			g.writeType(s, fun, depth, options...)
+			break
+		}
+
+		s.WriteString("undefined")
+	case *ast.CompositeLit:
 		s.WriteString("undefined")
 	default:
-		s.WriteString("any")
+		s.WriteString(g.conf.DefaultFallbackType)
 	}
 }
 
+// conversionType returns the target type of the specified
+// type conversion call expression (eg. "time.Duration" for "time.Duration(5)").
+//
+// It returns false if call is a regular func call.
+func (g *PackageGenerator) conversionType(call *ast.CallExpr) (ast.Expr, bool) {
+	if len(call.Args) != 1 || call.Ellipsis.IsValid() {
+		return nil, false
+	}
+
+	fun := call.Fun
+	for {
+		p, ok := fun.(*ast.ParenExpr)
+		if !ok {
+			break
+		}
+		fun = p.X
+	}
+
+	switch t := fun.(type) {
+	case *ast.Ident:
+		if _, ok := types.Universe.Lookup(t.Name).(*types.TypeName); ok {
+			return t, true
+		}
+		if g.lookupTypeName(t) != nil {
+			return t, true
+		}
+	case *ast.SelectorExpr:
+		if g.lookupTypeName(t) != nil {
+			return t, true
+		}
+	case *ast.ArrayType, *ast.MapType, *ast.StarExpr, *ast.FuncType, *ast.ChanType, *ast.InterfaceType:
+		return t, true
+	}
+
+	return nil, false
+}
+
+// mapIndexKeyType returns the TS index signature parameter type
+// of the specified Go map key type.
+//
+// Only the string and numeric key types are supported, including
+// the named ones (eg. "models.ID" declared as "type ID string").
+func (g *PackageGenerator) mapIndexKeyType(key ast.Expr) (string, bool) {
+	if ident, ok := key.(*ast.Ident); ok {
+		if ident.Name == "string" {
+			return "string", true
+		}
+
+		if exists(goNumericTypes, ident.Name) && !isGoComplex(ident.Name) {
+			return "number", true
+		}
+	}
+
+	typeName := g.lookupTypeName(key)
+	if typeName == nil {
+		return "", false
+	}
+
+	basic, ok := typeName.Type().Underlying().(*types.Basic)
+	if !ok {
+		return "", false
+	}
+
+	switch info := basic.Info(); {
+	case info&types.IsString != 0:
+		return "string", true
+	case info&(types.IsInteger|types.IsFloat) != 0:
+		return "number", true
+	}
+
+	return "", false
+}
+
+// writeMapTemplate writes the specified map type
+// using the Config.MapTypeTemplate.
+//
+// Fallbacks to the generic _TygojaDict on template execution error.
+func (g *PackageGenerator) writeMapTemplate(s *strings.Builder, t *ast.MapType, depth int) {
+	if g.mapTemplate == nil {
+		tmpl, err := template.New("map").Parse(g.conf.MapTypeTemplate)
+		if err != nil {
+			s.WriteString(BaseTypeDict)
+			return
+		}
+		g.mapTemplate = tmpl
+	}
+
+	keySB := new(strings.Builder)
+	g.writeType(keySB, t.Key, depth)
+
+	valueSB := new(strings.Builder)
+	g.writeType(valueSB, t.Value, depth)
+
+	data := struct {
+		Key   string
+		Value string
+	}{
+		Key:   keySB.String(),
+		Value: valueSB.String(),
+	}
+
+	result := new(strings.Builder)
+	if err := g.mapTemplate.Execute(result, data); err != nil {
+		s.WriteString(BaseTypeDict)
+		return
+	}
+
+	s.WriteString(result.String())
+}
+
+// needsArrayElementParenthesis checks whether the specified TS type
+// must be wrapped in parenthesis when used as shorthand array element
+// (eg. top level unions, intersections and function types).
+func needsArrayElementParenthesis(elt string) bool {
+	var level int
+
+	for i := 0; i < len(elt); i++ {
+		switch elt[i] {
+		case '(', '[', '{', '<':
+			level++
+		case ')', ']', '}':
+			level--
+		case '>':
+			if i > 0 && elt[i-1] == '=' {
+				if level == 0 {
+					return true // arrow function
+				}
+			} else {
+				level--
+			}
+		case '|', '&':
+			if level == 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isUnmappedByte checks whether t is the builtin byte identifier
+// without a custom TypeMappings entry.
+//
+// It is used to prevent the []byte special cases from overriding
+// an explicit user defined "byte" mapping.
+func (g *PackageGenerator) isUnmappedByte(t ast.Expr) bool {
+	v, ok := t.(*ast.Ident)
+	if !ok || v.Name != "byte" {
+		return false
+	}
+
+	_, isMapped := g.conf.TypeMappings[v.Name]
+
+	return !isMapped
+}
+
+// goNumericTypes is a list with the Go numeric builtin types.
+var goNumericTypes = []string{
+	"int", "int8", "int16", "int32", "int64",
+	"uint", "uint8", "uint16", "uint32", "uint64",
+	"float32", "float64",
+	"complex64", "complex128",
+	"uintptr", "byte", "rune",
+}
+
+// numericType returns the TS type of the specified Go numeric builtin type.
+func (g *PackageGenerator) numericType(name string) string {
+	if g.conf.Int64AsBigInt && isGo64BitInt(name) {
+		return "bigint"
+	}
+
+	if g.conf.DistinguishIntFloat {
+		return name // see Tygoja.writeBaseTypes
+	}
+
+	if isGoComplex(name) {
+		return g.conf.ComplexType
+	}
+
+	return g.conf.NumberType
+}
+
+// isGoComplex checks whether name is a Go complex number builtin type.
+func isGoComplex(name string) bool {
+	return name == "complex64" || name == "complex128"
+}
+
+// isGo64BitInt checks whether name is a Go 64-bit integer builtin type
+// that cannot be safely represented as JS number.
+func isGo64BitInt(name string) bool {
+	return name == "int64" || name == "uint64" || name == "uintptr"
+}
+
+// writeGenericInstance writes a generic type instantiation (eg. "Result[List[User]]").
+//
+// The base type and each type argument are resolved recursively (including TypeMappings).
+// If the base type is mapped to a non-generic TS builtin type (eg. "any"),
+// the type arguments are omitted.
+func (g *PackageGenerator) writeGenericInstance(s *strings.Builder, base ast.Expr, args []ast.Expr, depth int) {
+	baseSB := new(strings.Builder)
+	g.writeType(baseSB, base, depth)
+	baseType := baseSB.String()
+
+	s.WriteString(baseType)
+
+	if _, ok := tsBuiltinTypes[baseType]; ok {
+		return
+	}
+
+	s.WriteByte('<')
+	for i, arg := range args {
+		if i > 0 {
+			s.WriteString(", ")
+		}
+		g.writeType(s, arg, depth)
+	}
+	s.WriteByte('>')
+}
+
+// tsBuiltinTypes is a list with the non-generic TS builtin types.
+var tsBuiltinTypes = map[string]struct{}{
+	"any":       {},
+	"unknown":   {},
+	"never":     {},
+	"void":      {},
+	"undefined": {},
+	"null":      {},
+	"string":    {},
+	"number":    {},
+	"bigint":    {},
+	"boolean":   {},
+	"symbol":    {},
+	"object":    {},
+}
+
+// sourcePosition returns a short "file.go:line" source position of the specified node.
+func (g *PackageGenerator) sourcePosition(n ast.Node) string {
+	if g.pkg == nil || g.pkg.Fset == nil {
+		return "unknown position"
+	}
+
+	pos := g.pkg.Fset.Position(n.Pos())
+
+	return fmt.Sprintf("%s:%d", filepath.Base(pos.Filename), pos.Line)
+}
+
+// unwrapPointers strips all pointer levels of the specified type expression
+// (eg. "**T" -> "T") and reports whether t was a pointer.
+func unwrapPointers(t ast.Expr) (ast.Expr, bool) {
+	var isPointer bool
+
+	for {
+		p, ok := t.(*ast.StarExpr)
+		if !ok {
+			return t, isPointer
+		}
+
+		t = p.X
+		isPointer = true
+	}
+}
+
+// isEmptyStruct checks whether t is a struct type without fields (eg. "struct{}").
+func isEmptyStruct(t *ast.StructType) bool {
+	return t.Fields == nil || len(t.Fields.List) == 0
+}
+
+// isZeroLengthArray checks whether t is a zero length fixed array type (eg. "[0]int").
+func isZeroLengthArray(t *ast.ArrayType) bool {
+	lit, ok := t.Len.(*ast.BasicLit)
+
+	return ok && lit.Kind == token.INT && lit.Value == "0"
+}
+
+// fieldTagName extracts the field name from the specified struct field tag key
+// (eg. "name" for `js:"name,omitempty"`).
+//
+// It returns "-" and false if the tag value is exactly "-" (aka. excluded field).
+func fieldTagName(f *ast.Field, key string) (string, bool) {
+	if f.Tag == nil {
+		return "", true
+	}
+
+	rawTag, err := strconv.Unquote(f.Tag.Value)
+	if err != nil {
+		return "", true
+	}
+
+	value, ok := reflect.StructTag(rawTag).Lookup(key)
+	if !ok {
+		return "", true
+	}
+
+	if value == "-" {
+		return "-", false
+	}
+
+	name, _, _ := strings.Cut(value, ",")
+
+	return name, true
+}
+
+// fieldTagsJSDoc returns the JSDoc tags lines generated from
+// the struct field tags registered in Config.TagToJSDoc.
+//
+// For example, with TagToJSDoc{"doc": ""} the field tag
+// `doc:"readonly,since=1.2"` will result in []string{"@readonly", "@since 1.2"}.
+//
+// If Config.EmitStructTags is set, the raw field tag is also
+// appended as "@gotag" JSDoc tag (eg. `@gotag json:"name,omitempty"`).
+func (g *PackageGenerator) fieldTagsJSDoc(f *ast.Field) []string {
+	if (len(g.conf.TagToJSDoc) == 0 && !g.conf.EmitStructTags) || f.Tag == nil {
+		return nil
+	}
+
+	rawTag, err := strconv.Unquote(f.Tag.Value)
+	if err != nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(g.conf.TagToJSDoc))
+	for key := range g.conf.TagToJSDoc {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var result []string
+
+	for _, key := range keys {
+		value, ok := reflect.StructTag(rawTag).Lookup(key)
+		if !ok {
+			continue
+		}
+
+		// the tag value is used as it is
+		if jsdocTag := g.conf.TagToJSDoc[key]; jsdocTag != "" {
+			result = append(result, strings.TrimSpace("@"+jsdocTag+" "+value))
+			continue
+		}
+
+		// the tag value is a comma separated list of "name" or "name=value" JSDoc tags
+		for _, item := range strings.Split(value, ",") {
+			name, val, _ := strings.Cut(strings.TrimSpace(item), "=")
+			if name == "" {
+				continue
+			}
+			result = append(result, strings.TrimSpace("@"+name+" "+val))
+		}
+	}
+
+	// escape the comment terminator since the raw tag is written as it is
+	if g.conf.EmitStructTags && strings.TrimSpace(rawTag) != "" {
+		result = append(result, "@gotag "+strings.ReplaceAll(strings.TrimSpace(rawTag), "*/", `*\/`))
+	}
+
+	return result
+}
+
+// isUnsafePointer checks whether t is an unsafe.Pointer (or a pointer to it) type expression.
+func isUnsafePointer(t ast.Expr) bool {
+	if p, isPointer := t.(*ast.StarExpr); isPointer {
+		t = p.X
+	}
+
+	sel, ok := t.(*ast.SelectorExpr)
+	if !ok || sel.Sel == nil || sel.Sel.Name != "Pointer" {
+		return false
+	}
+
+	x, ok := sel.X.(*ast.Ident)
+
+	return ok && x.Name == "unsafe"
+}
+
+// typeParamNames extracts the type parameter names from the specified
+// generic declaration type params list (eg. "K" and "V" for "[K comparable, V any]").
+func typeParamNames(fields *ast.FieldList) []string {
+	if fields == nil {
+		return nil
+	}
+
+	var names []string
+	for _, f := range fields.List {
+		for _, ident := range f.Names {
+			names = append(names, ident.Name)
+		}
+	}
+
+	return names
+}
+
+// pushTypeParams registers the specified type parameter names as in scope
+// and returns them so that they can be unregistered later with popTypeParams.
+func (g *PackageGenerator) pushTypeParams(names []string) []string {
+	if g.typeParams == nil {
+		g.typeParams = map[string]int{}
+	}
+
+	for _, name := range names {
+		g.typeParams[name]++
+	}
+
+	return names
+}
+
+// popTypeParams unregisters the specified in scope type parameter names.
+func (g *PackageGenerator) popTypeParams(names []string) {
+	for _, name := range names {
+		g.typeParams[name]--
+	}
+}
+
+// declaredTypeParamName returns the TS name of the i-th declared type parameter.
+//
+// The blank type parameters are named by their position
+// (eg. "_T0") to avoid duplicated identifiers.
+func declaredTypeParamName(name string, i int) string {
+	if name == "_" {
+		return "_T" + strconv.Itoa(i)
+	}
+
+	return name
+}
+
 func (g *PackageGenerator) writeTypeParamsFields(s *strings.Builder, fields []*ast.Field) {
 	// extract params
 	names := []string{}
 	for _, f := range fields {
 		for _, ident := range f.Names {
-			names = append(names, ident.Name)
+			names = append(names, declaredTypeParamName(ident.Name, len(names)))
 
 			// disable extends for now as it complicates the interfaces merge
 			//
@@ -211,10 +783,76 @@ func (g *PackageGenerator) writeTypeParamsFields(s *strings.Builder, fields []*a
 	s.WriteByte('>')
 }
 
-func (g *PackageGenerator) writeInterfaceFields(s *strings.Builder, fields []*ast.Field, depth int) {
+// writeConstrainedTypeParamsFields writes the type parameters
+// together with their constraints (eg. "<K extends string,V>").
+//
+// It is used only for declarations that are not merged with other
+// interface declarations (eg. package level functions) because TS requires
+// all merged declarations to have identical type parameters.
+func (g *PackageGenerator) writeConstrainedTypeParamsFields(s *strings.Builder, fields []*ast.Field, depth int) {
+	var count int
+
+	constraintSB := new(strings.Builder)
+
 	for _, f := range fields {
-		g.writeCommentGroup(s, f.Doc, depth+1)
+		constraintSB.Reset()
+		g.writeTypeParamConstraint(constraintSB, f.Type, depth)
 
+		for _, ident := range f.Names {
+			if count == 0 {
+				s.WriteByte('<')
+			} else {
+				s.WriteString(",")
+			}
+			count++
+
+			s.WriteString(declaredTypeParamName(ident.Name, count-1))
+
+			if constraintSB.Len() > 0 {
+				s.WriteString(" extends ")
+				s.WriteString(constraintSB.String())
+			}
+		}
+	}
+
+	if count > 0 {
+		s.WriteByte('>')
+	}
+}
+
+// writeTypeParamConstraint writes the TS type of a type parameter constraint.
+//
+// Nothing is written for constraints without TS equivalent (eg. "any", "comparable").
+//
+// Constraints from other packages are written as regular type references
+// (eg. "constraints.Ordered") and are resolved to their generated union type alias.
+func (g *PackageGenerator) writeTypeParamConstraint(s *strings.Builder, constraint ast.Expr, depth int) {
+	switch t := constraint.(type) {
+	case *ast.Ident:
+		if t.Name == "any" || t.Name == "comparable" {
+			return
+		}
+	case *ast.InterfaceType:
+		// inline constraint interface (eg. "interface{ ~int | ~string }")
+		if terms := typeSetElements(t); len(terms) > 0 {
+			g.writeTypeSetElements(s, terms, depth)
+		}
+		return
+	case *ast.BinaryExpr, *ast.UnaryExpr:
+		// eg. "~int | ~string"
+		g.writeTypeSetElements(s, []ast.Expr{t}, depth)
+		return
+	}
+
+	g.writeType(s, constraint, depth, optionParenthesis)
+}
+
+func (g *PackageGenerator) writeInterfaceFields(s *strings.Builder, fields []*ast.Field, depth int) {
+	// reset the interface "this" type to prevent applying it to nested anonymous interfaces
+	thisType := g.interfaceThisType
+	g.interfaceThisType = ""
+
+	for _, f := range fields {
 		var methodName string
 		if len(f.Names) != 0 && f.Names[0] != nil && len(f.Names[0].Name) != 0 {
 			methodName = f.Names[0].Name
@@ -223,13 +861,25 @@ func (g *PackageGenerator) writeInterfaceFields(s *strings.Builder, fields []*as
 			continue
 		}
 
+		if g.isHidden(f.Doc) {
+			continue
+		}
+
 		if g.conf.MethodNameFormatter != nil {
 			methodName = g.conf.MethodNameFormatter(methodName)
 		}
 
+		g.writeCommentGroup(s, f.Doc, depth+1, g.resultsJSDoc(f.Type)...)
+
 		g.writeIndent(s, depth+1)
-		s.WriteString(methodName)
-		g.writeType(s, f.Type, depth)
+		writePropertyName(s, methodName)
+		if ft, ok := f.Type.(*ast.FuncType); ok {
+			// write directly as method signature to skip the special
+			// func types handling (eg. Config.IteratorsAsIterable)
+			g.writeFuncType(s, ft, depth, false, thisType, optionalParamsFrom(f.Doc))
+		} else {
+			g.writeType(s, f.Type, depth)
+		}
 
 		if f.Comment != nil {
 			s.WriteString(" // ")
@@ -240,41 +890,95 @@ func (g *PackageGenerator) writeInterfaceFields(s *strings.Builder, fields []*as
 	}
 }
 
-func (g *PackageGenerator) writeStructFields(s *strings.Builder, fields []*ast.Field, depth int) {
+// structField describes a single resolved struct field name and its declaration.
+type structField struct {
+	name  string
+	field *ast.Field
+}
+
+// resolveStructFields returns the list of the exported struct fields
+// with their final TS names (after applying the tags and formatters).
+func (g *PackageGenerator) resolveStructFields(fields []*ast.Field) []structField {
+	result := g.resolveOwnStructFields(fields)
+
+	// flatten the promoted fields of the unexported embedded structs
+	result = append(result, g.inlinedEmbedFields(fields, result, map[string]struct{}{})...)
+
+	if g.conf.SortFields {
+		sort.SliceStable(result, func(i, j int) bool {
+			return result[i].name < result[j].name
+		})
+	}
+
+	return result
+}
+
+// resolveOwnStructFields is similar to resolveStructFields but
+// returns only the directly declared struct fields (in their declaration order).
+func (g *PackageGenerator) resolveOwnStructFields(fields []*ast.Field) []structField {
+	result := make([]structField, 0, len(fields))
+
 	for _, f := range fields {
-		var fieldName string
-		if len(f.Names) != 0 && f.Names[0] != nil && len(f.Names[0].Name) != 0 {
-			fieldName = f.Names[0].Name
-		}
-		if len(fieldName) == 0 || 'A' > fieldName[0] || fieldName[0] > 'Z' {
-			continue
-		}
+		// normalize the fields iteration
+		// (fields with shortened type declaration will be part of a single ast.Field but with different names)
+		for _, ident := range f.Names {
+			// skip embedded, blank ("_") and unexported fields
+			if ident == nil || !ident.IsExported() {
+				continue
+			}
 
-		if g.conf.FieldNameFormatter != nil {
-			fieldName = g.conf.FieldNameFormatter(fieldName)
+			if g.conf.DropUnsafeFields && isUnsafePointer(f.Type) {
+				continue
+			}
+
+			if g.isHidden(f.Doc) {
+				continue
+			}
+
+			fieldName := ident.Name
+
+			if g.conf.FieldTagKey != "" {
+				tagName, ok := fieldTagName(f, g.conf.FieldTagKey)
+				if tagName == "-" && !ok {
+					continue // explicitly excluded
+				}
+				if tagName != "" {
+					fieldName = tagName
+				}
+			}
+
+			if g.conf.FieldNameFormatter != nil {
+				fieldName = g.conf.FieldNameFormatter(fieldName)
+			}
+
+			result = append(result, structField{name: fieldName, field: f})
 		}
+	}
 
-		g.writeCommentGroup(s, f.Doc, depth+1)
+	return result
+}
+
+func (g *PackageGenerator) writeStructFields(s *strings.Builder, fields []*ast.Field, depth int) {
+	for _, sf := range g.resolveStructFields(fields) {
+		f := sf.field
+
+		g.writeCommentGroup(s, f.Doc, depth+1, g.fieldTagsJSDoc(f)...)
 
 		g.writeIndent(s, depth+1)
-		quoted := !isValidJSName(fieldName)
-		if quoted {
-			s.WriteByte('\'')
-		}
-		s.WriteString(fieldName)
-		if quoted {
-			s.WriteByte('\'')
-		}
+		writePropertyName(s, sf.name)
 
 		// check if it is nil-able, aka. optional
-		switch t := f.Type.(type) {
-		case *ast.StarExpr:
-			f.Type = t.X
+		typ, isPointer := unwrapPointers(f.Type)
+		if isPointer || isOptionalField(f.Doc) {
 			s.WriteByte('?')
 		}
 
 		s.WriteString(": ")
-		g.writeType(s, f.Type, depth, optionParenthesis)
+		if override, ok := typeOverride(f.Doc); ok {
+			s.WriteString(override)
+		} else {
+			g.writeType(s, typ, depth, optionParenthesis)
+		}
 
 		if f.Comment != nil {
 			// Line comment is present, that means a comment after the field.
@@ -286,11 +990,26 @@ func (g *PackageGenerator) writeStructFields(s *strings.Builder, fields []*ast.F
 	}
 }
 
-func (g *PackageGenerator) writeFuncType(s *strings.Builder, t *ast.FuncType, depth int, returnAsProp bool) {
+// writeFuncType writes the specified func type signature.
+//
+// thisParam is the optional explicit "this" param type (see Config.ExplicitThisParam)
+// and optionalFrom is the 0-based index of the first optional param (-1 for none).
+//
+// Note that both are applied only to the current func type and not to the nested ones
+// (eg. a callback param).
+func (g *PackageGenerator) writeFuncType(s *strings.Builder, t *ast.FuncType, depth int, returnAsProp bool, thisParam string, optionalFrom int) {
 	s.WriteString("(")
 
+	if thisParam != "" {
+		s.WriteString("this: ")
+		s.WriteString(thisParam)
+		if t.Params != nil && len(t.Params.List) > 0 {
+			s.WriteString(", ")
+		}
+	}
+
 	if t.Params != nil {
-		g.writeFuncParams(s, t.Params.List, depth)
+		g.writeFuncParams(s, t.Params.List, depth, optionalFrom)
 	}
 
 	if returnAsProp {
@@ -306,32 +1025,42 @@ func (g *PackageGenerator) writeFuncType(s *strings.Builder, t *ast.FuncType, de
 	// Note that if there are exactly two return values and the last is an `error`,
 	// the function returns the first value as is, not an Array.
 	if t.Results == nil || len(t.Results.List) == 0 {
-		s.WriteString("void")
+		s.WriteString(g.conf.FuncReturnVoidType)
 	} else {
 		// remove the last return error type
-		lastReturn, ok := t.Results.List[len(t.Results.List)-1].Type.(*ast.Ident)
+		// (without modifying the AST to allow writing the same func type multiple times)
+		results := t.Results.List
+		last := results[len(results)-1]
+		lastReturn, ok := last.Type.(*ast.Ident)
 		if ok && lastReturn.Name == "error" {
-			t.Results.List = t.Results.List[:len(t.Results.List)-1]
+			results = results[:len(results)-1]
+
+			// shortened error results (eg. "(a, b error)") drop only the last name
+			if len(last.Names) > 1 {
+				trimmed := *last
+				trimmed.Names = last.Names[:len(last.Names)-1]
+				results = append(results[:len(results):len(results)], &trimmed)
+			}
 		}
 
-		if len(t.Results.List) == 0 {
-			s.WriteString("void")
+		if len(results) == 0 {
+			s.WriteString(g.conf.FuncReturnVoidType)
 		} else {
 			// multiple and shortened return type values must be wrapped in []
 			// (combined/shortened return values from the same type are part of a single ast.Field but with different names)
-			hasMultipleReturnValues := len(t.Results.List) > 1 || len(t.Results.List[0].Names) > 1
+			hasMultipleReturnValues := len(results) > 1 || len(results[0].Names) > 1
 			if hasMultipleReturnValues {
 				s.WriteRune('[')
 			}
 
-			for i, f := range t.Results.List {
+			for i, f := range results {
 				totalNames := max(len(f.Names), 1)
 				for j := range totalNames {
 					if i > 0 || j > 0 {
 						s.WriteString(", ")
 					}
 
-					g.writeType(s, f.Type, 0, optionParenthesis, optionFunctionReturn)
+					g.writeType(s, f.Type, depth, optionParenthesis, optionFunctionReturn)
 				}
 			}
 
@@ -342,14 +1071,22 @@ func (g *PackageGenerator) writeFuncType(s *strings.Builder, t *ast.FuncType, de
 	}
 }
 
-func (g *PackageGenerator) writeFuncParams(s *strings.Builder, params []*ast.Field, depth int) {
+// writeFuncParams writes the specified func params list.
+//
+// The non-variadic params starting from the optionalFrom index are
+// marked as optional (use a negative value to disable).
+func (g *PackageGenerator) writeFuncParams(s *strings.Builder, params []*ast.Field, depth int, optionalFrom int) {
+	var index int
+
+	written := []string{}
+
 	for i, f := range params {
 		// normalize params iteration
 		// (params with omitted types will be part of a single ast.Field but with different names)
 		names := make([]string, 0, len(f.Names))
 		for j, ident := range f.Names {
 			name := ident.Name
-			if name == "" || isReservedIdentifier(name) {
+			if _, ok := restrictedParamNames[name]; ok || name == "" || name == "_" || isReservedIdentifier(name) {
 				name = fmt.Sprintf("_arg%d%d", i, j)
 			}
 			names = append(names, name)
@@ -359,17 +1096,15 @@ func (g *PackageGenerator) writeFuncParams(s *strings.Builder, params []*ast.Fie
 			names = append(names, fmt.Sprintf("_arg%d", i))
 		}
 
-		for j, fieldName := range names {
-			if i > 0 || j > 0 {
-				s.WriteString(", ")
-			}
+		for _, fieldName := range names {
+			s := new(strings.Builder)
 
 			var isVariadic bool
 
-			switch t := f.Type.(type) {
-			case *ast.StarExpr:
-				f.Type = t.X
-			case *ast.Ellipsis:
+			// note: only the top level pointer is stripped, the pointer elements
+			// of the slice and variadic params preserve their nullability (eg. "...(User | undefined)[]")
+			typ, _ := unwrapPointers(f.Type)
+			if _, ok := typ.(*ast.Ellipsis); ok {
 				isVariadic = true
 			}
 
@@ -379,9 +1114,14 @@ func (g *PackageGenerator) writeFuncParams(s *strings.Builder, params []*ast.Fie
 			}
 			s.WriteString(fieldName)
 
+			if !isVariadic && optionalFrom >= 0 && index >= optionalFrom {
+				s.WriteByte('?')
+			}
+			index++
+
 			s.WriteString(": ")
 
-			g.writeType(s, f.Type, depth, optionParenthesis)
+			g.writeType(s, typ, depth, optionParenthesis)
 
 			if f.Comment != nil {
 				// Line comment is present, that means a comment after the field.
@@ -389,8 +1129,47 @@ func (g *PackageGenerator) writeFuncParams(s *strings.Builder, params []*ast.Fie
 				s.WriteString(f.Comment.Text())
 				s.WriteString(" */ ")
 			}
+
+			written = append(written, s.String())
+		}
+	}
+
+	joined := strings.Join(written, ", ")
+
+	if g.conf.MaxLineLength == 0 || len(written) == 0 || lineLength(s)+len(joined)+1 <= g.conf.MaxLineLength {
+		s.WriteString(joined)
+		return
+	}
+
+	// wrap each param on a separate line
+	// (without trailing comma since it is not allowed after a rest param)
+	indent := lineIndent(s)
+	s.WriteByte('\n')
+	for i, p := range written {
+		s.WriteString(indent)
+		s.WriteString(g.conf.Indent)
+		s.WriteString(p)
+		if i < len(written)-1 {
+			s.WriteByte(',')
 		}
+		s.WriteByte('\n')
 	}
+	s.WriteString(indent)
+}
+
+// lineLength returns the length of the last (aka. currently written) line of s.
+func lineLength(s *strings.Builder) int {
+	str := s.String()
+
+	return len(str) - strings.LastIndexByte(str, '\n') - 1
+}
+
+// lineIndent returns the leading whitespace of the last (aka. currently written) line of s.
+func lineIndent(s *strings.Builder) string {
+	str := s.String()
+	line := str[strings.LastIndexByte(str, '\n')+1:]
+
+	return line[:len(line)-len(strings.TrimLeft(line, " \t"))]
 }
 
 // see https://es5.github.io/#x7.6.1.1
@@ -437,6 +1216,21 @@ var reservedIdentifiers = map[string]struct{}{
 	"package":    {},
 	"protected":  {},
 	"static":     {},
+
+	// null and boolean literals (valid Go identifiers)
+	"null":  {},
+	"true":  {},
+	"false": {},
+
+	// reserved in the ES modules code
+	"await": {},
+}
+
+// restrictedParamNames is a list with the identifiers that
+// are not reserved but can't be used as strict mode param names.
+var restrictedParamNames = map[string]struct{}{
+	"arguments": {},
+	"eval":      {},
 }
 
 func isReservedIdentifier(name string) bool {
@@ -446,8 +1240,61 @@ func isReservedIdentifier(name string) bool {
 
 var isValidJSNameRegexp = regexp.MustCompile(`(?m)^[\pL_][\pL\pN_]*$`)
 
+// isValidJSName checks whether name could be used as bare property name.
+//
+// The reserved identifiers are reported as invalid so that they
+// are always quoted (eg. "'class': string").
 func isValidJSName(name string) bool {
-	return isReservedIdentifier(name) || isValidJSNameRegexp.MatchString(name)
+	return !isReservedIdentifier(name) && isValidJSNameRegexp.MatchString(name)
+}
+
+// writePropertyName writes the specified field or method name,
+// quoting it if it is not a valid JS identifier (eg. 'class' or 'my-field').
+func writePropertyName(s *strings.Builder, name string) {
+	if isValidJSName(name) {
+		s.WriteString(name)
+		return
+	}
+
+	s.WriteString(jsStringLiteral(name))
+}
+
+// jsStringLiteral returns the single quoted JS string literal of the specified value.
+//
+// Unlike strconv.Quote, only escape sequences valid in JS are used
+// (the invalid UTF-8 bytes are escaped as "\xNN" code units).
+func jsStringLiteral(value string) string {
+	var sb strings.Builder
+
+	sb.WriteByte('\'')
+
+	for i := 0; i < len(value); {
+		r, size := utf8.DecodeRuneInString(value[i:])
+
+		switch {
+		case r == utf8.RuneError && size == 1:
+			fmt.Fprintf(&sb, "\\x%02x", value[i])
+		case r == '\'' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r == '\n':
+			sb.WriteString("\\n")
+		case r == '\r':
+			sb.WriteString("\\r")
+		case r == '\t':
+			sb.WriteString("\\t")
+		case r < 0x20 || r == 0x7f || r == '\u2028' || r == '\u2029':
+			fmt.Fprintf(&sb, "\\u%04x", r)
+		default:
+			sb.WriteRune(r)
+		}
+
+		i += size
+	}
+
+	sb.WriteByte('\'')
+
+	return sb.String()
 }
 
 func hasOption(opt string, options []string) bool {